@@ -0,0 +1,7 @@
+data "archestra_default_profile" "current" {}
+
+# Attach a tool to whichever profile is the organization default
+resource "archestra_profile_tool" "default_read_file" {
+  profile_id = data.archestra_default_profile.current.id
+  tool_id    = var.read_file_tool_id
+}
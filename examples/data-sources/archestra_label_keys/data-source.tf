@@ -0,0 +1,5 @@
+data "archestra_label_keys" "all" {}
+
+output "label_keys" {
+  value = data.archestra_label_keys.all.keys
+}
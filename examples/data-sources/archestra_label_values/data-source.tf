@@ -0,0 +1,8 @@
+# Values used with a single label key
+data "archestra_label_values" "environments" {
+  key = "environment"
+}
+
+output "environments" {
+  value = data.archestra_label_values.environments.values
+}
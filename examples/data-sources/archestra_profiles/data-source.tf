@@ -0,0 +1,17 @@
+# Every profile labelled env=prod
+data "archestra_profiles" "prod" {
+  labels = {
+    env = "prod"
+  }
+}
+
+# Apply the same limit to each production profile
+resource "archestra_limit" "prod" {
+  for_each = { for p in data.archestra_profiles.prod.profiles : p.name => p.id }
+
+  entity_type = "profile"
+  entity_id   = each.value
+  limit_type  = "token_cost"
+  limit_value = 1000
+  model       = "gpt-4o"
+}
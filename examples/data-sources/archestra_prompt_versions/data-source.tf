@@ -0,0 +1,11 @@
+data "archestra_prompt_versions" "support" {
+  prompt_id = archestra_prompt.support.id
+}
+
+output "support_prompt_active_version" {
+  value = data.archestra_prompt_versions.support.active_version
+}
+
+output "support_prompt_history" {
+  value = [for v in data.archestra_prompt_versions.support.versions : "${v.version}: ${v.created_at}"]
+}
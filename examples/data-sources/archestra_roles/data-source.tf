@@ -0,0 +1,5 @@
+data "archestra_roles" "all" {}
+
+output "custom_roles" {
+  value = [for r in data.archestra_roles.all.roles : r.role if !r.predefined]
+}
@@ -0,0 +1,5 @@
+data "archestra_secrets_manager" "current" {}
+
+output "secrets_backend" {
+  value = data.archestra_secrets_manager.current.type
+}
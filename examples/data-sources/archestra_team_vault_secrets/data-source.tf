@@ -0,0 +1,18 @@
+data "archestra_team_vault_secrets" "engineering" {
+  team_id = archestra_team_vault_folder.engineering.team_id
+}
+
+locals {
+  # Map of secret path to its key names
+  engineering_vault_keys = {
+    for secret in data.archestra_team_vault_secrets.engineering.secrets : secret.path => secret.keys
+  }
+}
+
+# Fail the plan early if a secret the catalog relies on is missing a key
+check "github_token_present" {
+  assert {
+    condition     = contains(lookup(local.engineering_vault_keys, "secret/data/archestra/engineering/github", []), "token")
+    error_message = "The engineering vault folder has no github secret with a \"token\" key."
+  }
+}
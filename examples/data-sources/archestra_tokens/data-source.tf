@@ -0,0 +1,11 @@
+# All organization and team tokens
+data "archestra_tokens" "all" {}
+
+# Tokens of a single team
+data "archestra_tokens" "engineering" {
+  team_id = archestra_team.engineering.id
+}
+
+output "unused_tokens" {
+  value = [for t in data.archestra_tokens.all.tokens : t.name if t.last_used_at == null]
+}
@@ -0,0 +1,19 @@
+data "archestra_tokens" "engineering" {
+  team_id = archestra_team.engineering.id
+}
+
+ephemeral "archestra_token_value" "engineering" {
+  token_id = data.archestra_tokens.engineering.tokens[0].id
+}
+
+# Pass the token to another provider without storing it in the archestra state
+resource "kubernetes_secret_v1" "archestra_token" {
+  metadata {
+    name      = "archestra-token"
+    namespace = "agents"
+  }
+
+  data_wo = {
+    token = ephemeral.archestra_token_value.engineering.value
+  }
+}
@@ -0,0 +1,12 @@
+ephemeral "archestra_user_token_value" "current" {}
+
+# Copy the personal token into Vault without persisting it in state
+resource "vault_kv_secret_v2" "archestra_token" {
+  mount = "secret"
+  name  = "archestra/token"
+
+  data_json_wo = jsonencode({
+    token = ephemeral.archestra_user_token_value.current.value
+  })
+  data_json_wo_version = 1
+}
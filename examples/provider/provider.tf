@@ -9,4 +9,7 @@ terraform {
 provider "archestra" {
   base_url = "http://localhost:9000" # Optional, defaults to http://localhost:9000
   api_key  = "your-api-key-here"     # Required - can also use ARCHESTRA_API_KEY env var
+
+  # Optional - reject profile labels whose key is not in this list
+  allowed_label_keys = ["environment", "team", "region"]
 }
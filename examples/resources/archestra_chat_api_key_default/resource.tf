@@ -0,0 +1,27 @@
+resource "archestra_chat_llm_provider_api_key" "openai_primary" {
+  name         = "OpenAI Primary"
+  api_key      = var.openai_primary_api_key
+  llm_provider = "openai"
+
+  # The default is managed by archestra_chat_api_key_default below
+  lifecycle {
+    ignore_changes = [is_organization_default]
+  }
+}
+
+resource "archestra_chat_llm_provider_api_key" "openai_backup" {
+  name         = "OpenAI Backup"
+  api_key      = var.openai_backup_api_key
+  llm_provider = "openai"
+
+  # The default is managed by archestra_chat_api_key_default below
+  lifecycle {
+    ignore_changes = [is_organization_default]
+  }
+}
+
+# Point this at openai_backup to switch the default in a single step
+resource "archestra_chat_api_key_default" "openai" {
+  llm_provider = "openai"
+  api_key_id   = archestra_chat_llm_provider_api_key.openai_primary.id
+}
@@ -4,3 +4,20 @@ resource "archestra_chat_llm_provider_api_key" "example" {
   llm_provider            = "openai"
   is_organization_default = true
 }
+
+# With Terraform 1.11 or later, keep the key out of state entirely.
+# Bump api_key_wo_version whenever the key is rotated.
+resource "archestra_chat_llm_provider_api_key" "write_only" {
+  name               = "Production Anthropic Key"
+  api_key_wo         = var.anthropic_api_key
+  api_key_wo_version = 1
+  llm_provider       = "anthropic"
+}
+
+# Bill specific agent profiles against a dedicated key
+resource "archestra_chat_llm_provider_api_key" "research" {
+  name         = "Research OpenAI Key"
+  api_key      = var.research_openai_api_key
+  llm_provider = "openai"
+  profile_ids  = [archestra_profile.research.id]
+}
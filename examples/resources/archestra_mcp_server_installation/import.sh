@@ -0,0 +1,7 @@
+# MCP server installations can be imported by their ID. The API does not return
+# `name`, `profile_ids` or the installation inputs (`environment_values`,
+# `user_config_values`, `access_token`, `access_token_wo_version`,
+# `is_byos_vault`), so they are null after import. Keeping them in configuration
+# does not reinstall the server: the next apply records them in state as an
+# in-place update. Changing them after that reinstalls the server.
+terraform import archestra_mcp_server_installation.example 00000000-0000-0000-0000-000000000000
@@ -19,3 +19,35 @@ resource "archestra_mcp_server_installation" "example" {
   name          = "my-filesystem-server"
   mcp_server_id = archestra_mcp_registry_catalog_item.filesystem.id
 }
+
+# Supply values for environment variables the catalog item prompts for on
+# installation
+variable "api_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "archestra_mcp_server_installation" "with_credentials" {
+  name          = "my-filesystem-server-with-credentials"
+  mcp_server_id = archestra_mcp_registry_catalog_item.filesystem.id
+
+  environment_values = {
+    API_TOKEN = var.api_token
+  }
+}
+
+# Install a server shared with a team and attach its tools to a profile
+resource "archestra_team" "platform" {
+  name = "platform"
+}
+
+resource "archestra_profile" "assistant" {
+  name = "assistant"
+}
+
+resource "archestra_mcp_server_installation" "team" {
+  name          = "platform-filesystem-server"
+  mcp_server_id = archestra_mcp_registry_catalog_item.filesystem.id
+  team_id       = archestra_team.platform.id
+  profile_ids   = [archestra_profile.assistant.id]
+}
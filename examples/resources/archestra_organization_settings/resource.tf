@@ -4,5 +4,15 @@ resource "archestra_organization_settings" "example" {
   compression_scope            = "organization"
   onboarding_complete          = true
   convert_tool_results_to_toon = true
+  auto_configure_new_tools     = true
   limit_cleanup_interval       = "24h"
+
+  # Plans compare the file's digest with logo_sha256 instead of diffing the
+  # encoded image.
+  logo_file = "${path.module}/logo.png"
+}
+
+# Gate bootstrapping steps on the organization having seen real traffic.
+output "llm_proxy_in_use" {
+  value = archestra_organization_settings.example.has_llm_proxy_logs
 }
@@ -16,3 +16,10 @@ resource "archestra_profile" "example" {
     }
   ]
 }
+
+# Restrict a profile to specific teams and treat its context as untrusted
+resource "archestra_profile" "restricted" {
+  name                       = "finance-profile"
+  teams                      = [archestra_team.finance.id]
+  consider_context_untrusted = true
+}
@@ -0,0 +1,8 @@
+resource "archestra_profile" "primary" {
+  name = "primary-profile"
+}
+
+# Point profile_id at another profile to move the default in a single step
+resource "archestra_profile_default" "this" {
+  profile_id = archestra_profile.primary.id
+}
@@ -0,0 +1,20 @@
+# Let Archestra generate policies for the filesystem tools assigned to the
+# research profile. Review the result with `terraform state show` and copy any
+# policy worth keeping into an explicit policy resource.
+resource "archestra_profile_tool_policy_autoconfig" "research" {
+  profile_id = archestra_profile.research.id
+  tool_ids   = [for tool in data.archestra_mcp_server_tool.filesystem : tool.id]
+
+  # Bump to generate the policies again, for example after upgrading the MCP server
+  triggers = {
+    server_version = "1.2.0"
+  }
+
+  depends_on = [archestra_profile_tools.research]
+}
+
+output "generated_tool_result_treatments" {
+  value = {
+    for tool in archestra_profile_tool_policy_autoconfig.research.tools : tool.tool_id => tool.tool_result_treatment
+  }
+}
@@ -0,0 +1,17 @@
+# Every tool on the profile is untrusted and blocked once untrusted data is
+# present, except the tools that are configured individually below
+resource "archestra_profile_tool_security_baseline" "research" {
+  profile_id                                 = archestra_profile.research.id
+  tool_result_treatment                      = "untrusted"
+  allow_usage_when_untrusted_data_is_present = false
+
+  exclude_tool_ids = [
+    data.archestra_mcp_server_tool.read_text_file.id,
+  ]
+}
+
+resource "archestra_profile_tool" "read_text_file" {
+  profile_id            = archestra_profile.research.id
+  tool_id               = data.archestra_mcp_server_tool.read_text_file.id
+  tool_result_treatment = "trusted"
+}
@@ -0,0 +1,41 @@
+resource "archestra_profile" "research" {
+  name = "Research Profile"
+}
+
+resource "archestra_mcp_registry_catalog_item" "filesystem" {
+  name = "filesystem-mcp-server"
+
+  local_config = {
+    command   = "npx"
+    arguments = ["-y", "@modelcontextprotocol/server-filesystem", "./"]
+  }
+}
+
+resource "archestra_mcp_server_installation" "filesystem" {
+  name          = "my-filesystem-server"
+  mcp_server_id = archestra_mcp_registry_catalog_item.filesystem.id
+}
+
+locals {
+  filesystem_tools = ["read_text_file", "list_directory", "search_files"]
+}
+
+data "archestra_mcp_server_tool" "filesystem" {
+  for_each = toset(local.filesystem_tools)
+
+  mcp_server_id = archestra_mcp_server_installation.filesystem.id
+  name          = "${archestra_mcp_registry_catalog_item.filesystem.name}__${each.key}"
+}
+
+# Assign every filesystem tool in a single apply step
+resource "archestra_profile_tools" "research" {
+  profile_id = archestra_profile.research.id
+
+  tools = [
+    for tool in data.archestra_mcp_server_tool.filesystem : {
+      tool_id                         = tool.id
+      credential_source_mcp_server_id = archestra_mcp_server_installation.filesystem.id
+      execution_source_mcp_server_id  = archestra_mcp_server_installation.filesystem.id
+    }
+  ]
+}
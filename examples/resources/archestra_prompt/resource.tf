@@ -0,0 +1,23 @@
+resource "archestra_profile" "support" {
+  name = "support-agent"
+}
+
+resource "archestra_prompt" "support" {
+  profile_id    = archestra_profile.support.id
+  name          = "support-agent-prompt"
+  system_prompt = file("${path.module}/prompts/support-system.md")
+  user_prompt   = "Answer the customer's question using the knowledge base."
+}
+
+output "support_prompt_version" {
+  value = archestra_prompt.support.version
+}
+
+# Roll back to an earlier version without losing the newer ones.
+# Remove pinned_version to re-activate the latest version.
+resource "archestra_prompt" "triage" {
+  profile_id     = archestra_profile.support.id
+  name           = "triage-prompt"
+  system_prompt  = "Classify the incoming ticket by urgency."
+  pinned_version = 2
+}
@@ -0,0 +1,20 @@
+resource "archestra_role" "profile_operator" {
+  name = "profile-operator"
+
+  permission = {
+    agent = ["create", "read", "update"]
+    tool  = ["read"]
+  }
+}
+
+# Assign the custom role to team members
+resource "archestra_team" "operators" {
+  name = "Operators"
+
+  members = [
+    {
+      user_id = "user-id-here"
+      role    = archestra_role.profile_operator.role
+    }
+  ]
+}
@@ -0,0 +1,5 @@
+# Store secrets in HashiCorp Vault instead of the Archestra database.
+# The Vault connection itself is configured on the Archestra server.
+resource "archestra_secrets_manager" "this" {
+  type = "Vault"
+}
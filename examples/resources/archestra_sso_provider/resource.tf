@@ -0,0 +1,56 @@
+resource "archestra_sso_provider" "google" {
+  provider_id = "google"
+  domain      = "example.com"
+  issuer      = "https://accounts.google.com"
+
+  oidc_config = {
+    issuer             = "https://accounts.google.com"
+    client_id          = var.google_client_id
+    client_secret      = var.google_client_secret
+    discovery_endpoint = "https://accounts.google.com/.well-known/openid-configuration"
+    scopes             = ["openid", "email", "profile"]
+
+    mapping = {
+      id    = "sub"
+      email = "email"
+      name  = "name"
+      image = "picture"
+    }
+  }
+}
+
+variable "google_client_id" {
+  type = string
+}
+
+variable "google_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "archestra_sso_provider" "okta" {
+  provider_id = "okta"
+  domain      = "corp.example.com"
+  issuer      = "http://www.okta.com/exk1234567890"
+
+  saml_config = {
+    issuer            = "http://www.okta.com/exk1234567890"
+    entry_point       = "https://example.okta.com/app/example/exk1234567890/sso/saml"
+    cert              = file("${path.module}/okta.pem")
+    callback_url      = "https://archestra.example.com/api/auth/sso/saml2/callback/okta"
+    identifier_format = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+    digest_algorithm  = "sha256"
+  }
+
+  # Users in the "archestra-admins" group become admins, everyone else members
+  role_mapping = {
+    rules = [
+      {
+        expression = "{{#includes groups \"archestra-admins\"}}true{{/includes}}"
+        role       = "admin"
+      }
+    ]
+    default_role = "member"
+    strict_mode  = false
+  }
+}
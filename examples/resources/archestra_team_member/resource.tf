@@ -0,0 +1,12 @@
+# A shared team defined in one stack, without a members list
+resource "archestra_team" "platform" {
+  name        = "Platform"
+  description = "Shared platform team"
+}
+
+# Memberships can then be added from any stack
+resource "archestra_team_member" "alice" {
+  team_id = archestra_team.platform.id
+  user_id = "user-id-here"
+  role    = "admin"
+}
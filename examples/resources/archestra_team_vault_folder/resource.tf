@@ -0,0 +1,9 @@
+resource "archestra_team" "engineering" {
+  name = "Engineering"
+}
+
+# Requires the Vault secrets backend (see archestra_secrets_manager)
+resource "archestra_team_vault_folder" "engineering" {
+  team_id    = archestra_team.engineering.id
+  vault_path = "secret/data/archestra/engineering"
+}
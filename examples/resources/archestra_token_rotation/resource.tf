@@ -0,0 +1,16 @@
+data "archestra_tokens" "engineering" {
+  team_id = archestra_team.engineering.id
+}
+
+# Rotate the engineering team token every 30 days
+resource "time_rotating" "engineering_token" {
+  rotation_days = 30
+}
+
+resource "archestra_token_rotation" "engineering" {
+  token_id = data.archestra_tokens.engineering.tokens[0].id
+
+  triggers = {
+    rotation = time_rotating.engineering_token.id
+  }
+}
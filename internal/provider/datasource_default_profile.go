@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DefaultProfileDataSource{}
+
+func NewDefaultProfileDataSource() datasource.DataSource {
+	return &DefaultProfileDataSource{}
+}
+
+// DefaultProfileDataSource defines the data source implementation.
+type DefaultProfileDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// DefaultProfileDataSourceModel describes the data source data model.
+type DefaultProfileDataSourceModel struct {
+	ID                       types.String        `tfsdk:"id"`
+	Name                     types.String        `tfsdk:"name"`
+	Labels                   []ProfileLabelModel `tfsdk:"labels"`
+	Teams                    types.Set           `tfsdk:"teams"`
+	ConsiderContextUntrusted types.Bool          `tfsdk:"consider_context_untrusted"`
+	IsDemo                   types.Bool          `tfsdk:"is_demo"`
+}
+
+func (d *DefaultProfileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_profile"
+}
+
+func (d *DefaultProfileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the organization default Archestra profile.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Profile identifier",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the profile",
+				Computed:            true,
+			},
+			"labels": schema.ListNestedAttribute{
+				MarkdownDescription: "Labels attached to the profile",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Label key",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Label value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"teams": schema.SetAttribute{
+				MarkdownDescription: "IDs of the teams that have access to the profile",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"consider_context_untrusted": schema.BoolAttribute{
+				MarkdownDescription: "Whether all context passed to the profile is treated as untrusted data",
+				Computed:            true,
+			},
+			"is_demo": schema.BoolAttribute{
+				MarkdownDescription: "Whether the profile is a demo profile",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DefaultProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DefaultProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DefaultProfileDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := d.client.GetDefaultAgentWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read default profile, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON404 != nil {
+		resp.Diagnostics.AddError("Not Found", "The organization has no default profile")
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()))
+		return
+	}
+
+	data.ID = types.StringValue(apiResp.JSON200.Id.String())
+	data.Name = types.StringValue(apiResp.JSON200.Name)
+	data.ConsiderContextUntrusted = types.BoolValue(apiResp.JSON200.ConsiderContextUntrusted)
+	data.IsDemo = types.BoolValue(apiResp.JSON200.IsDemo)
+
+	data.Labels = make([]ProfileLabelModel, 0, len(apiResp.JSON200.Labels))
+	for _, label := range apiResp.JSON200.Labels {
+		data.Labels = append(data.Labels, ProfileLabelModel{
+			Key:   types.StringValue(label.Key),
+			Value: types.StringValue(label.Value),
+		})
+	}
+
+	teamIDs := make([]string, 0, len(apiResp.JSON200.Teams))
+	for _, team := range apiResp.JSON200.Teams {
+		teamIDs = append(teamIDs, team.Id)
+	}
+	teams, diags := types.SetValueFrom(ctx, types.StringType, teamIDs)
+	resp.Diagnostics.Append(diags...)
+	data.Teams = teams
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDefaultProfileDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDefaultProfileDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.archestra_default_profile.current", "id"),
+					resource.TestCheckResourceAttrSet("data.archestra_default_profile.current", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDefaultProfileDataSourceConfig() string {
+	return `
+data "archestra_default_profile" "current" {}
+`
+}
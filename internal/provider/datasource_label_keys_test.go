@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLabelKeysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccLabelKeysDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.archestra_label_keys.all", "keys.*", "tf-acc-test-key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLabelKeysDataSourceConfig() string {
+	return `
+resource "archestra_profile" "test" {
+  name = "tf-acc-test-label-keys"
+
+  labels = [
+    {
+      key   = "tf-acc-test-key"
+      value = "tf-acc-test-value"
+    }
+  ]
+}
+
+data "archestra_label_keys" "all" {
+  depends_on = [archestra_profile.test]
+}
+`
+}
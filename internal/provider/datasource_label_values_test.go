@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLabelValuesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccLabelValuesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.archestra_label_values.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("data.archestra_label_values.test", "values.0", "tf-acc-test-a"),
+					resource.TestCheckResourceAttr("data.archestra_label_values.test", "values.1", "tf-acc-test-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLabelValuesDataSourceConfig() string {
+	return `
+resource "archestra_profile" "a" {
+  name = "tf-acc-test-label-values-a"
+
+  labels = [
+    {
+      key   = "tf-acc-test-values-key"
+      value = "tf-acc-test-b"
+    }
+  ]
+}
+
+resource "archestra_profile" "b" {
+  name = "tf-acc-test-label-values-b"
+
+  labels = [
+    {
+      key   = "tf-acc-test-values-key"
+      value = "tf-acc-test-a"
+    }
+  ]
+}
+
+data "archestra_label_values" "test" {
+  key = "tf-acc-test-values-key"
+
+  depends_on = [archestra_profile.a, archestra_profile.b]
+}
+`
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// profilesPageSize is the number of profiles requested per page when listing.
+const profilesPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProfilesDataSource{}
+
+func NewProfilesDataSource() datasource.DataSource {
+	return &ProfilesDataSource{}
+}
+
+// ProfilesDataSource defines the data source implementation.
+type ProfilesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// ProfileSummaryModel describes a single profile in the list.
+type ProfileSummaryModel struct {
+	ID                       types.String        `tfsdk:"id"`
+	Name                     types.String        `tfsdk:"name"`
+	Labels                   []ProfileLabelModel `tfsdk:"labels"`
+	Teams                    types.Set           `tfsdk:"teams"`
+	ConsiderContextUntrusted types.Bool          `tfsdk:"consider_context_untrusted"`
+	IsDefault                types.Bool          `tfsdk:"is_default"`
+	IsDemo                   types.Bool          `tfsdk:"is_demo"`
+}
+
+// ProfilesDataSourceModel describes the data source data model.
+type ProfilesDataSourceModel struct {
+	Name          types.String          `tfsdk:"name"`
+	Labels        types.Map             `tfsdk:"labels"`
+	SortBy        types.String          `tfsdk:"sort_by"`
+	SortDirection types.String          `tfsdk:"sort_direction"`
+	Profiles      []ProfileSummaryModel `tfsdk:"profiles"`
+}
+
+func (d *ProfilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profiles"
+}
+
+func (d *ProfilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches all Archestra profiles, optionally filtered by name and labels.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return profiles whose name matches this value",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Only return profiles that carry every one of these label key/value pairs",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"sort_by": schema.StringAttribute{
+				MarkdownDescription: "Field to sort profiles by (`createdAt`, `name`, `team` or `toolsCount`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.GetAgentsParamsSortByCreatedAt),
+						string(client.GetAgentsParamsSortByName),
+						string(client.GetAgentsParamsSortByTeam),
+						string(client.GetAgentsParamsSortByToolsCount),
+					),
+				},
+			},
+			"sort_direction": schema.StringAttribute{
+				MarkdownDescription: "Sort direction (`asc` or `desc`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.GetAgentsParamsSortDirectionAsc),
+						string(client.GetAgentsParamsSortDirectionDesc),
+					),
+				},
+			},
+			"profiles": schema.ListNestedAttribute{
+				MarkdownDescription: "List of matching profiles",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Profile identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the profile",
+							Computed:            true,
+						},
+						"labels": schema.ListNestedAttribute{
+							MarkdownDescription: "Labels attached to the profile",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										MarkdownDescription: "Label key",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "Label value",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"teams": schema.SetAttribute{
+							MarkdownDescription: "IDs of the teams that have access to the profile",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"consider_context_untrusted": schema.BoolAttribute{
+							MarkdownDescription: "Whether all context passed to the profile is treated as untrusted data",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the profile is the organization default profile",
+							Computed:            true,
+						},
+						"is_demo": schema.BoolAttribute{
+							MarkdownDescription: "Whether the profile is a demo profile",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProfilesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labelFilter := map[string]string{}
+	if !data.Labels.IsNull() {
+		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labelFilter, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	limit := profilesPageSize
+	params := client.GetAgentsParams{
+		Name:          data.Name.ValueStringPointer(),
+		Limit:         &limit,
+		SortBy:        (*client.GetAgentsParamsSortBy)(data.SortBy.ValueStringPointer()),
+		SortDirection: (*client.GetAgentsParamsSortDirection)(data.SortDirection.ValueStringPointer()),
+	}
+
+	data.Profiles = []ProfileSummaryModel{}
+	for offset := 0; ; {
+		params.Offset = &offset
+
+		apiResp, err := d.client.GetAgentsWithResponse(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read profiles, got error: %s", err))
+			return
+		}
+
+		if apiResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()))
+			return
+		}
+
+		for _, profile := range apiResp.JSON200.Data {
+			labels := make(map[string]string, len(profile.Labels))
+			model := ProfileSummaryModel{
+				ID:                       types.StringValue(profile.Id.String()),
+				Name:                     types.StringValue(profile.Name),
+				Labels:                   make([]ProfileLabelModel, 0, len(profile.Labels)),
+				ConsiderContextUntrusted: types.BoolValue(profile.ConsiderContextUntrusted),
+				IsDefault:                types.BoolValue(profile.IsDefault),
+				IsDemo:                   types.BoolValue(profile.IsDemo),
+			}
+
+			for _, label := range profile.Labels {
+				labels[label.Key] = label.Value
+				model.Labels = append(model.Labels, ProfileLabelModel{
+					Key:   types.StringValue(label.Key),
+					Value: types.StringValue(label.Value),
+				})
+			}
+
+			if !matchesLabels(labels, labelFilter) {
+				continue
+			}
+
+			teamIDs := make([]string, 0, len(profile.Teams))
+			for _, team := range profile.Teams {
+				teamIDs = append(teamIDs, team.Id)
+			}
+			teams, diags := types.SetValueFrom(ctx, types.StringType, teamIDs)
+			resp.Diagnostics.Append(diags...)
+			model.Teams = teams
+
+			data.Profiles = append(data.Profiles, model)
+		}
+
+		if !apiResp.JSON200.Pagination.HasNext || len(apiResp.JSON200.Data) == 0 {
+			break
+		}
+		offset += len(apiResp.JSON200.Data)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matchesLabels reports whether labels contains every key/value pair in filter.
+func matchesLabels(labels map[string]string, filter map[string]string) bool {
+	for key, value := range filter {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
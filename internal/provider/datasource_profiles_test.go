@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProfilesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProfilesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.archestra_profiles.all", "profiles.#"),
+					resource.TestCheckResourceAttr("data.archestra_profiles.prod", "profiles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.archestra_profiles.prod", "profiles.0.id", "archestra_profile.prod", "id"),
+					resource.TestCheckResourceAttr("data.archestra_profiles.prod", "profiles.0.labels.0.value", "tf-acc-test-prod"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProfilesDataSourceConfig() string {
+	return `
+resource "archestra_profile" "prod" {
+  name = "tf-acc-test-profiles-prod"
+
+  labels = [
+    {
+      key   = "env"
+      value = "tf-acc-test-prod"
+    }
+  ]
+}
+
+resource "archestra_profile" "staging" {
+  name = "tf-acc-test-profiles-staging"
+
+  labels = [
+    {
+      key   = "env"
+      value = "tf-acc-test-staging"
+    }
+  ]
+}
+
+data "archestra_profiles" "all" {
+  sort_by        = "name"
+  sort_direction = "asc"
+
+  depends_on = [archestra_profile.prod, archestra_profile.staging]
+}
+
+data "archestra_profiles" "prod" {
+  labels = {
+    env = "tf-acc-test-prod"
+  }
+
+  depends_on = [archestra_profile.prod, archestra_profile.staging]
+}
+`
+}
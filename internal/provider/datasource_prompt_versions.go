@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PromptVersionsDataSource{}
+
+func NewPromptVersionsDataSource() datasource.DataSource {
+	return &PromptVersionsDataSource{}
+}
+
+// PromptVersionsDataSource defines the data source implementation.
+type PromptVersionsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// PromptVersionModel describes a single prompt version.
+type PromptVersionModel struct {
+	ID           types.String `tfsdk:"id"`
+	Version      types.Int64  `tfsdk:"version"`
+	Name         types.String `tfsdk:"name"`
+	SystemPrompt types.String `tfsdk:"system_prompt"`
+	UserPrompt   types.String `tfsdk:"user_prompt"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+// PromptVersionsDataSourceModel describes the data source data model.
+type PromptVersionsDataSourceModel struct {
+	PromptID        types.String         `tfsdk:"prompt_id"`
+	ActiveVersion   types.Int64          `tfsdk:"active_version"`
+	ActiveVersionID types.String         `tfsdk:"active_version_id"`
+	Versions        []PromptVersionModel `tfsdk:"versions"`
+}
+
+func (d *PromptVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt_versions"
+}
+
+func (d *PromptVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the version history of an Archestra prompt.",
+
+		Attributes: map[string]schema.Attribute{
+			"prompt_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of any version of the prompt",
+				Required:            true,
+			},
+			"active_version": schema.Int64Attribute{
+				MarkdownDescription: "Version number of the currently active version",
+				Computed:            true,
+			},
+			"active_version_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the currently active version",
+				Computed:            true,
+			},
+			"versions": schema.ListNestedAttribute{
+				MarkdownDescription: "All versions of the prompt, ordered by version number",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Prompt version identifier",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "Version number",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the prompt in this version",
+							Computed:            true,
+						},
+						"system_prompt": schema.StringAttribute{
+							MarkdownDescription: "The system prompt text of this version",
+							Computed:            true,
+						},
+						"user_prompt": schema.StringAttribute{
+							MarkdownDescription: "The user prompt text of this version",
+							Computed:            true,
+						},
+						"is_active": schema.BoolAttribute{
+							MarkdownDescription: "Whether this version is the active one",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp of this version",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PromptVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PromptVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PromptVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promptID, err := uuid.Parse(data.PromptID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("prompt_id"), "Invalid Prompt ID", fmt.Sprintf("Unable to parse prompt ID: %s", err))
+		return
+	}
+
+	apiResp, err := d.client.GetPromptVersionsWithResponse(ctx, promptID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read prompt versions, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON404 != nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Prompt with ID %s not found", data.PromptID.ValueString()))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()))
+		return
+	}
+
+	versions := *apiResp.JSON200
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+
+	data.ActiveVersion = types.Int64Null()
+	data.ActiveVersionID = types.StringNull()
+	data.Versions = make([]PromptVersionModel, len(versions))
+	for i, v := range versions {
+		data.Versions[i] = PromptVersionModel{
+			ID:           types.StringValue(v.Id.String()),
+			Version:      types.Int64Value(int64(v.Version)),
+			Name:         types.StringValue(v.Name),
+			SystemPrompt: types.StringPointerValue(v.SystemPrompt),
+			UserPrompt:   types.StringPointerValue(v.UserPrompt),
+			IsActive:     types.BoolValue(v.IsActive),
+			CreatedAt:    types.StringValue(v.CreatedAt.Format(time.RFC3339)),
+		}
+
+		if v.IsActive {
+			data.ActiveVersion = types.Int64Value(int64(v.Version))
+			data.ActiveVersionID = types.StringValue(v.Id.String())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPromptVersionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccPromptVersionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.archestra_prompt_versions.test", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.archestra_prompt_versions.test", "versions.0.version", "1"),
+					resource.TestCheckResourceAttr("data.archestra_prompt_versions.test", "versions.0.is_active", "true"),
+					resource.TestCheckResourceAttr("data.archestra_prompt_versions.test", "active_version", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.archestra_prompt_versions.test", "active_version_id",
+						"archestra_prompt.test", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccPromptVersionsDataSourceConfig() string {
+	return `
+resource "archestra_profile" "test" {
+  name = "tf-acc-test-prompt-versions-profile"
+}
+
+resource "archestra_prompt" "test" {
+  profile_id    = archestra_profile.test.id
+  name          = "tf-acc-test-prompt-versions"
+  system_prompt = "You are a helpful assistant."
+}
+
+data "archestra_prompt_versions" "test" {
+  prompt_id = archestra_prompt.test.id
+}
+`
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretsManagerDataSource{}
+
+func NewSecretsManagerDataSource() datasource.DataSource {
+	return &SecretsManagerDataSource{}
+}
+
+// SecretsManagerDataSource defines the data source implementation.
+type SecretsManagerDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// SecretsManagerDataSourceModel describes the data source data model.
+type SecretsManagerDataSourceModel struct {
+	Type types.String `tfsdk:"type"`
+	Meta types.Map    `tfsdk:"meta"`
+}
+
+func (d *SecretsManagerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_manager"
+}
+
+func (d *SecretsManagerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the secrets backend currently used by Archestra.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Active secrets backend type (`DB`, `Vault` or `BYOS_VAULT`)",
+				Computed:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Backend-specific metadata reported by the server",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SecretsManagerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SecretsManagerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretsManagerDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := d.client.GetSecretsTypeWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read secrets manager type, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()))
+		return
+	}
+
+	data.Type = types.StringValue(string(apiResp.JSON200.Type))
+
+	meta, diags := types.MapValueFrom(ctx, types.StringType, apiResp.JSON200.Meta)
+	resp.Diagnostics.Append(diags...)
+	data.Meta = meta
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
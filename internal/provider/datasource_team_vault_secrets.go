@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamVaultSecretsDataSource{}
+
+func NewTeamVaultSecretsDataSource() datasource.DataSource {
+	return &TeamVaultSecretsDataSource{}
+}
+
+// TeamVaultSecretsDataSource defines the data source implementation.
+type TeamVaultSecretsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// TeamVaultSecretModel describes a single secret in a team vault folder.
+type TeamVaultSecretModel struct {
+	Name types.String `tfsdk:"name"`
+	Path types.String `tfsdk:"path"`
+	Keys types.List   `tfsdk:"keys"`
+}
+
+// TeamVaultSecretsDataSourceModel describes the data source data model.
+type TeamVaultSecretsDataSourceModel struct {
+	TeamID  types.String           `tfsdk:"team_id"`
+	Secrets []TeamVaultSecretModel `tfsdk:"secrets"`
+}
+
+func (d *TeamVaultSecretsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_vault_secrets"
+}
+
+func (d *TeamVaultSecretsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the secrets in a team's vault folder together with their key names. Secret values are never read.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team",
+				Required:            true,
+			},
+			"secrets": schema.ListNestedAttribute{
+				MarkdownDescription: "Secrets in the team's vault folder, ordered by path",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Secret name",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Full vault path of the secret",
+							Computed:            true,
+						},
+						"keys": schema.ListAttribute{
+							MarkdownDescription: "Key names stored in the secret",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamVaultSecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamVaultSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamVaultSecretsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := data.TeamID.ValueString()
+
+	apiResp, err := d.client.ListTeamVaultFolderSecretsWithResponse(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to list team vault secrets, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON404 != nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Vault folder for team %s not found", teamID))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()))
+		return
+	}
+
+	secrets := *apiResp.JSON200
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Path < secrets[j].Path
+	})
+
+	data.Secrets = make([]TeamVaultSecretModel, len(secrets))
+	for i, secret := range secrets {
+		keysResp, err := d.client.GetTeamVaultSecretKeysWithResponse(ctx, teamID, client.GetTeamVaultSecretKeysJSONRequestBody{
+			SecretPath: secret.Path,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read keys of vault secret %s, got error: %s", secret.Path, err))
+			return
+		}
+
+		if keysResp.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("Expected 200 OK reading keys of vault secret %s, got status %d", secret.Path, keysResp.StatusCode()),
+			)
+			return
+		}
+
+		keys := keysResp.JSON200.Keys
+		sort.Strings(keys)
+
+		keyList, diags := types.ListValueFrom(ctx, types.StringType, keys)
+		resp.Diagnostics.Append(diags...)
+
+		data.Secrets[i] = TeamVaultSecretModel{
+			Name: types.StringValue(secret.Name),
+			Path: types.StringValue(secret.Path),
+			Keys: keyList,
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
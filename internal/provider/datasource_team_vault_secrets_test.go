@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamVaultSecretsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccTeamVaultFolderPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamVaultSecretsDataSourceConfig(os.Getenv("ARCHESTRA_TEST_VAULT_PATH")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.archestra_team_vault_secrets.test", "team_id", "archestra_team.test", "id"),
+					resource.TestCheckResourceAttrSet("data.archestra_team_vault_secrets.test", "secrets.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamVaultSecretsDataSourceConfig(vaultPath string) string {
+	return fmt.Sprintf(`
+resource "archestra_team" "test" {
+  name = "tf-acc-test-vault-secrets-team"
+}
+
+resource "archestra_team_vault_folder" "test" {
+  team_id    = archestra_team.test.id
+  vault_path = %[1]q
+}
+
+data "archestra_team_vault_secrets" "test" {
+  team_id = archestra_team_vault_folder.test.team_id
+}
+`, vaultPath)
+}
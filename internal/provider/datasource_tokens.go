@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TokensDataSource{}
+
+func NewTokensDataSource() datasource.DataSource {
+	return &TokensDataSource{}
+}
+
+// TokensDataSource defines the data source implementation.
+type TokensDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// TokenModel describes a single organization or team token.
+type TokenModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	TokenStart          types.String `tfsdk:"token_start"`
+	IsOrganizationToken types.Bool   `tfsdk:"is_organization_token"`
+	TeamID              types.String `tfsdk:"team_id"`
+	TeamName            types.String `tfsdk:"team_name"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	LastUsedAt          types.String `tfsdk:"last_used_at"`
+}
+
+// TokensDataSourceModel describes the data source data model.
+type TokensDataSourceModel struct {
+	TeamID types.String `tfsdk:"team_id"`
+	Tokens []TokenModel `tfsdk:"tokens"`
+}
+
+func (d *TokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tokens"
+}
+
+func (d *TokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the organization and team tokens in Archestra. Token values are not exposed; use the `archestra_token_value` ephemeral resource to read them.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Only return tokens belonging to this team",
+				Optional:            true,
+			},
+			"tokens": schema.ListNestedAttribute{
+				MarkdownDescription: "List of tokens",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Token identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the token",
+							Computed:            true,
+						},
+						"token_start": schema.StringAttribute{
+							MarkdownDescription: "First characters of the token value, for identification",
+							Computed:            true,
+						},
+						"is_organization_token": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is the organization-wide token",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the team the token belongs to, if any",
+							Computed:            true,
+						},
+						"team_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the team the token belongs to, if any",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp of the token",
+							Computed:            true,
+						},
+						"last_used_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp of the last use of the token, if it has been used",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TokensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TokensDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := d.client.GetTokensWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read tokens, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()))
+		return
+	}
+
+	data.Tokens = make([]TokenModel, 0, len(*apiResp.JSON200))
+	for _, token := range *apiResp.JSON200 {
+		if !data.TeamID.IsNull() && (token.Team == nil || token.Team.Id != data.TeamID.ValueString()) {
+			continue
+		}
+
+		model := TokenModel{
+			ID:                  types.StringValue(token.Id.String()),
+			Name:                types.StringValue(token.Name),
+			TokenStart:          types.StringValue(token.TokenStart),
+			IsOrganizationToken: types.BoolValue(token.IsOrganizationToken),
+			TeamID:              types.StringNull(),
+			TeamName:            types.StringNull(),
+			CreatedAt:           types.StringValue(token.CreatedAt.Format(time.RFC3339)),
+			LastUsedAt:          types.StringNull(),
+		}
+
+		if token.Team != nil {
+			model.TeamID = types.StringValue(token.Team.Id)
+			model.TeamName = types.StringValue(token.Team.Name)
+		}
+
+		if token.LastUsedAt != nil {
+			model.LastUsedAt = types.StringValue(token.LastUsedAt.Format(time.RFC3339))
+		}
+
+		data.Tokens = append(data.Tokens, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTokensDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTokensDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.archestra_tokens.all", "tokens.#"),
+					resource.TestCheckResourceAttr("data.archestra_tokens.none", "tokens.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTokensDataSourceConfig() string {
+	return `
+data "archestra_tokens" "all" {}
+
+data "archestra_tokens" "none" {
+  team_id = "00000000-0000-0000-0000-000000000000"
+}
+`
+}
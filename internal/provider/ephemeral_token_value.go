@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &TokenValueEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &TokenValueEphemeralResource{}
+
+func NewTokenValueEphemeralResource() ephemeral.EphemeralResource {
+	return &TokenValueEphemeralResource{}
+}
+
+// TokenValueEphemeralResource defines the ephemeral resource implementation.
+type TokenValueEphemeralResource struct {
+	client *client.ClientWithResponses
+}
+
+// TokenValueEphemeralResourceModel describes the ephemeral resource data model.
+type TokenValueEphemeralResourceModel struct {
+	TokenID types.String `tfsdk:"token_id"`
+	Value   types.String `tfsdk:"value"`
+}
+
+func (e *TokenValueEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token_value"
+}
+
+func (e *TokenValueEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the value of an Archestra organization or team token without storing it in Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"token_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the token",
+				Required:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The token value",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *TokenValueEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+func (e *TokenValueEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TokenValueEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenID, err := uuid.Parse(data.TokenID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("token_id"), "Invalid Token ID", fmt.Sprintf("Unable to parse token ID: %s", err))
+		return
+	}
+
+	apiResp, err := e.client.GetTokenValueWithResponse(ctx, tokenID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read token value, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON404 != nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Token with ID %s not found", data.TokenID.ValueString()))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()))
+		return
+	}
+
+	data.Value = types.StringValue(apiResp.JSON200.Value)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &UserTokenValueEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &UserTokenValueEphemeralResource{}
+
+func NewUserTokenValueEphemeralResource() ephemeral.EphemeralResource {
+	return &UserTokenValueEphemeralResource{}
+}
+
+// UserTokenValueEphemeralResource defines the ephemeral resource implementation.
+type UserTokenValueEphemeralResource struct {
+	client *client.ClientWithResponses
+}
+
+// UserTokenValueEphemeralResourceModel describes the ephemeral resource data model.
+type UserTokenValueEphemeralResourceModel struct {
+	Value types.String `tfsdk:"value"`
+}
+
+func (e *UserTokenValueEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_token_value"
+}
+
+func (e *UserTokenValueEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the personal token value of the user the provider authenticates as, without storing it in Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The token value",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *UserTokenValueEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+func (e *UserTokenValueEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data UserTokenValueEphemeralResourceModel
+
+	apiResp, err := e.client.GetUserTokenValueWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read user token value, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()))
+		return
+	}
+
+	data.Value = types.StringValue(apiResp.JSON200.Value)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
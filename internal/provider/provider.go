@@ -7,6 +7,7 @@ import (
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +17,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ provider.Provider = &ArchestraProvider{}
+var _ provider.ProviderWithEphemeralResources = &ArchestraProvider{}
 
 // ArchestraProvider defines the provider implementation.
 type ArchestraProvider struct {
@@ -23,12 +25,17 @@ type ArchestraProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// allowedLabelKeys restricts the label keys profiles may use. Empty means
+	// any key is allowed.
+	allowedLabelKeys []string
 }
 
 // ArchestraProviderModel describes the provider data model.
 type ArchestraProviderModel struct {
-	BaseURL types.String `tfsdk:"base_url"`
-	APIKey  types.String `tfsdk:"api_key"`
+	BaseURL          types.String `tfsdk:"base_url"`
+	APIKey           types.String `tfsdk:"api_key"`
+	AllowedLabelKeys types.List   `tfsdk:"allowed_label_keys"`
 }
 
 func (p *ArchestraProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -50,6 +57,12 @@ func (p *ArchestraProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"allowed_label_keys": schema.ListAttribute{
+				MarkdownDescription: "Label keys that `archestra_profile` resources may use. When set, profiles using any other key fail validation, " +
+					"which catches typos before they create new label keys. Use the `archestra_label_keys` data source to list the keys in use.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -117,6 +130,10 @@ func (p *ArchestraProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	if !config.AllowedLabelKeys.IsNull() && !config.AllowedLabelKeys.IsUnknown() {
+		resp.Diagnostics.Append(config.AllowedLabelKeys.ElementsAs(ctx, &p.allowedLabelKeys, false)...)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -140,15 +157,16 @@ func (p *ArchestraProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Make the Archestra client available during DataSource and Resource
-	// type Configure methods.
+	// Make the Archestra client available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	resp.DataSourceData = apiClient
 	resp.ResourceData = apiClient
+	resp.EphemeralResourceData = apiClient
 }
 
 func (p *ArchestraProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		NewProfileResource,
+		p.newProfileResource,
 		NewMCPServerResource,
 		NewMCPServerRegistryResource,
 		NewTrustedDataPolicyResource,
@@ -161,8 +179,20 @@ func (p *ArchestraProvider) Resources(ctx context.Context) []func() resource.Res
 		// NewUserResource, // TODO: Enable when user API endpoints are implemented
 		NewTeamExternalGroupResource,
 		NewChatLLMProviderApiKeyResource,
+		NewChatApiKeyDefaultResource,
 		NewDualLlmConfigResource,
 		NewProfileToolResource,
+		NewPromptResource,
+		NewRoleResource,
+		NewSSOProviderResource,
+		NewSecretsManagerResource,
+		NewTeamVaultFolderResource,
+		NewTeamMemberResource,
+		NewTokenRotationResource,
+		NewProfileDefaultResource,
+		NewProfileToolsResource,
+		NewProfileToolSecurityBaselineResource,
+		NewProfileToolPolicyAutoconfigResource,
 	}
 }
 
@@ -174,6 +204,22 @@ func (p *ArchestraProvider) DataSources(ctx context.Context) []func() datasource
 		NewMCPServerToolDataSource,
 		NewTokenPricesDataSource,
 		NewTeamExternalGroupsDataSource,
+		NewPromptVersionsDataSource,
+		NewRolesDataSource,
+		NewSecretsManagerDataSource,
+		NewTeamVaultSecretsDataSource,
+		NewTokensDataSource,
+		NewDefaultProfileDataSource,
+		NewProfilesDataSource,
+		NewLabelKeysDataSource,
+		NewLabelValuesDataSource,
+	}
+}
+
+func (p *ArchestraProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTokenValueEphemeralResource,
+		NewUserTokenValueEphemeralResource,
 	}
 }
 
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChatApiKeyDefaultResource{}
+var _ resource.ResourceWithImportState = &ChatApiKeyDefaultResource{}
+
+func NewChatApiKeyDefaultResource() resource.Resource {
+	return &ChatApiKeyDefaultResource{}
+}
+
+// ChatApiKeyDefaultResource defines the resource implementation.
+type ChatApiKeyDefaultResource struct {
+	client *client.ClientWithResponses
+}
+
+// ChatApiKeyDefaultResourceModel describes the resource data model.
+type ChatApiKeyDefaultResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LLMProvider types.String `tfsdk:"llm_provider"`
+	ApiKeyID    types.String `tfsdk:"api_key_id"`
+}
+
+func (r *ChatApiKeyDefaultResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chat_api_key_default"
+}
+
+func (r *ChatApiKeyDefaultResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Designates the organization default chat API key for an LLM provider. This is a singleton resource per provider. " +
+			"Switching `api_key_id` moves the default in a single step, so the provider never has zero or two default keys. " +
+			"The `archestra_chat_llm_provider_api_key` resources involved must ignore changes to `is_organization_default`, " +
+			"which otherwise defaults to false and resets the flag. " +
+			"Can be imported by LLM provider name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the default designation (same as `llm_provider`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"llm_provider": schema.StringAttribute{
+				MarkdownDescription: "LLM provider the default applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.CreateChatApiKeyJSONBodyProviderAnthropic),
+						string(client.CreateChatApiKeyJSONBodyProviderGemini),
+						string(client.CreateChatApiKeyJSONBodyProviderOpenai),
+					),
+				},
+			},
+			"api_key_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the chat API key to use as the default. The key must belong to `llm_provider`",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ChatApiKeyDefaultResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ChatApiKeyDefaultResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ChatApiKeyDefaultResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setDefault(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChatApiKeyDefaultResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ChatApiKeyDefaultResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	llmProvider := data.LLMProvider.ValueString()
+	if data.LLMProvider.IsNull() {
+		llmProvider = data.ID.ValueString()
+	}
+
+	apiResp, err := r.client.GetChatApiKeysWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read chat LLM provider API keys, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	for _, key := range *apiResp.JSON200 {
+		if string(key.Provider) != llmProvider || !key.IsOrganizationDefault {
+			continue
+		}
+
+		data.ID = types.StringValue(llmProvider)
+		data.LLMProvider = types.StringValue(llmProvider)
+		data.ApiKeyID = types.StringValue(key.Id.String())
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// The provider no longer has a default key
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ChatApiKeyDefaultResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ChatApiKeyDefaultResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting a new default replaces the previous one on the server, so the
+	// old key is deliberately not unset first.
+	r.setDefault(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChatApiKeyDefaultResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ChatApiKeyDefaultResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.ApiKeyID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse chat LLM provider API key ID: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.UnsetChatApiKeyDefaultWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to unset chat LLM provider API key as default, got error: %s", err))
+		return
+	}
+
+	// Check response (200 or 404 are both acceptable for delete)
+	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+}
+
+func (r *ChatApiKeyDefaultResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setDefault verifies that the key belongs to the configured provider and marks it as the default.
+func (r *ChatApiKeyDefaultResource) setDefault(ctx context.Context, data *ChatApiKeyDefaultResourceModel, diags *diag.Diagnostics) {
+	id, err := uuid.Parse(data.ApiKeyID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("api_key_id"), "Invalid API Key ID", fmt.Sprintf("Unable to parse chat LLM provider API key ID: %s", err))
+		return
+	}
+
+	keyResp, err := r.client.GetChatApiKeyWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read chat LLM provider API key, got error: %s", err))
+		return
+	}
+
+	if keyResp.JSON404 != nil {
+		diags.AddAttributeError(path.Root("api_key_id"), "API Key Not Found", fmt.Sprintf("Chat LLM provider API key with ID %s not found", id))
+		return
+	}
+
+	if keyResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", keyResp.StatusCode()),
+		)
+		return
+	}
+
+	if string(keyResp.JSON200.Provider) != data.LLMProvider.ValueString() {
+		diags.AddAttributeError(
+			path.Root("api_key_id"),
+			"API Key Provider Mismatch",
+			fmt.Sprintf("Chat LLM provider API key %s belongs to %s, not %s", id, keyResp.JSON200.Provider, data.LLMProvider.ValueString()),
+		)
+		return
+	}
+
+	apiResp, err := r.client.SetChatApiKeyDefaultWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to set chat LLM provider API key as default, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK when setting default, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.LLMProvider.ValueString())
+	data.ApiKeyID = types.StringValue(apiResp.JSON200.Id.String())
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChatApiKeyDefaultResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccChatApiKeyDefaultResourceConfig("primary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_chat_api_key_default.test", "id", "openai"),
+					resource.TestCheckResourceAttrPair("archestra_chat_api_key_default.test", "api_key_id", "archestra_chat_llm_provider_api_key.primary", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_chat_api_key_default.test",
+				ImportState:       true,
+				ImportStateId:     "openai",
+				ImportStateVerify: true,
+			},
+			// Swap the default to the other key
+			{
+				Config: testAccChatApiKeyDefaultResourceConfig("secondary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_chat_api_key_default.test", "api_key_id", "archestra_chat_llm_provider_api_key.secondary", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccChatApiKeyDefaultResourceProviderMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "archestra_chat_llm_provider_api_key" "gemini" {
+  name         = "tf-acc-test-default-gemini"
+  api_key      = "test-api-key-value"
+  llm_provider = "gemini"
+}
+
+resource "archestra_chat_api_key_default" "test" {
+  llm_provider = "openai"
+  api_key_id   = archestra_chat_llm_provider_api_key.gemini.id
+}
+`,
+				ExpectError: regexp.MustCompile(`API Key Provider Mismatch`),
+			},
+		},
+	})
+}
+
+func testAccChatApiKeyDefaultResourceConfig(defaultKey string) string {
+	return fmt.Sprintf(`
+resource "archestra_chat_llm_provider_api_key" "primary" {
+  name         = "tf-acc-test-default-primary"
+  api_key      = "test-api-key-value"
+  llm_provider = "openai"
+
+  lifecycle {
+    ignore_changes = [is_organization_default]
+  }
+}
+
+resource "archestra_chat_llm_provider_api_key" "secondary" {
+  name         = "tf-acc-test-default-secondary"
+  api_key      = "test-api-key-value"
+  llm_provider = "openai"
+
+  lifecycle {
+    ignore_changes = [is_organization_default]
+  }
+}
+
+resource "archestra_chat_api_key_default" "test" {
+  llm_provider = "openai"
+  api_key_id   = archestra_chat_llm_provider_api_key.%[1]s.id
+}
+`, defaultKey)
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,8 +34,11 @@ type ChatLLMProviderApiKeyResourceModel struct {
 	ID                    types.String `tfsdk:"id"`
 	Name                  types.String `tfsdk:"name"`
 	ApiKey                types.String `tfsdk:"api_key"`
+	ApiKeyWO              types.String `tfsdk:"api_key_wo"`
+	ApiKeyWOVersion       types.Int64  `tfsdk:"api_key_wo_version"`
 	LLMProvider           types.String `tfsdk:"llm_provider"`
 	IsOrganizationDefault types.Bool   `tfsdk:"is_organization_default"`
+	ProfileIDs            types.Set    `tfsdk:"profile_ids"`
 }
 
 func (r *ChatLLMProviderApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,9 +62,29 @@ func (r *ChatLLMProviderApiKeyResource) Schema(ctx context.Context, req resource
 				Required:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "The API key value",
-				Required:            true,
+				MarkdownDescription: "The API key value. This value is stored in Terraform state; prefer `api_key_wo` where supported. Exactly one of `api_key` or `api_key_wo` must be set",
+				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("api_key_wo")),
+				},
+			},
+			"api_key_wo": schema.StringAttribute{
+				MarkdownDescription: "The API key value, write-only and never stored in Terraform state. Requires Terraform 1.11 or later. " +
+					"Increment `api_key_wo_version` to send a new value to the server",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("api_key")),
+				},
+			},
+			"api_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `api_key_wo`. Changing it updates the API key on the server with the current value of `api_key_wo`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("api_key_wo")),
+				},
 			},
 			"llm_provider": schema.StringAttribute{
 				MarkdownDescription: "LLM provider for this API key",
@@ -76,10 +101,17 @@ func (r *ChatLLMProviderApiKeyResource) Schema(ctx context.Context, req resource
 				},
 			},
 			"is_organization_default": schema.BoolAttribute{
-				MarkdownDescription: "Whether this API key is the organization default for the provider",
+				MarkdownDescription: "Whether this API key is the organization default for the provider (default: false). " +
+					"Conflicts with `archestra_chat_api_key_default`: when that resource manages the default, add `is_organization_default` " +
+					"to `lifecycle.ignore_changes` on the keys it points at, otherwise this attribute resets the flag on every apply",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"profile_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the profiles that use this API key. When set, this list is authoritative and assignments made elsewhere are reverted; leave it unset to manage assignments outside Terraform",
 				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
+				ElementType:         types.StringType,
 			},
 		},
 	}
@@ -107,14 +139,23 @@ func (r *ChatLLMProviderApiKeyResource) Create(ctx context.Context, req resource
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// Write-only values are only available in the configuration
+	var apiKeyWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &apiKeyWO)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	apiKey := data.ApiKey.ValueString()
+	if !apiKeyWO.IsNull() {
+		apiKey = apiKeyWO.ValueString()
+	}
+
 	isDefault := data.IsOrganizationDefault.ValueBool()
 	requestBody := client.CreateChatApiKeyJSONRequestBody{
 		Name:                  data.Name.ValueString(),
-		ApiKey:                data.ApiKey.ValueString(),
+		ApiKey:                apiKey,
 		Provider:              client.CreateChatApiKeyJSONBodyProvider(data.LLMProvider.ValueString()),
 		IsOrganizationDefault: &isDefault,
 	}
@@ -138,6 +179,16 @@ func (r *ChatLLMProviderApiKeyResource) Create(ctx context.Context, req resource
 	data.LLMProvider = types.StringValue(string(apiResp.JSON200.Provider))
 	data.IsOrganizationDefault = types.BoolValue(apiResp.JSON200.IsOrganizationDefault)
 
+	if !data.ProfileIDs.IsNull() {
+		r.updateProfiles(ctx, apiResp.JSON200.Id, data.ProfileIDs, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// The key exists, so keep it in state to avoid orphaning it
+			data.ProfileIDs = types.SetNull(types.StringType)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -175,10 +226,24 @@ func (r *ChatLLMProviderApiKeyResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	// Profile assignments are only tracked when managed, or when importing
+	importing := data.Name.IsNull()
+
 	data.Name = types.StringValue(apiResp.JSON200.Name)
 	data.LLMProvider = types.StringValue(string(apiResp.JSON200.Provider))
 	data.IsOrganizationDefault = types.BoolValue(apiResp.JSON200.IsOrganizationDefault)
 
+	if !data.ProfileIDs.IsNull() || (importing && len(apiResp.JSON200.Profiles) > 0) {
+		profileIDs := make([]string, len(apiResp.JSON200.Profiles))
+		for i, profile := range apiResp.JSON200.Profiles {
+			profileIDs[i] = profile.Id.String()
+		}
+
+		var diags diag.Diagnostics
+		data.ProfileIDs, diags = types.SetValueFrom(ctx, types.StringType, profileIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -189,6 +254,9 @@ func (r *ChatLLMProviderApiKeyResource) Update(ctx context.Context, req resource
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	var apiKeyWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &apiKeyWO)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -200,10 +268,19 @@ func (r *ChatLLMProviderApiKeyResource) Update(ctx context.Context, req resource
 	}
 
 	name := data.Name.ValueString()
-	apiKey := data.ApiKey.ValueString()
 	requestBody := client.UpdateChatApiKeyJSONRequestBody{
-		Name:   &name,
-		ApiKey: &apiKey,
+		Name: &name,
+	}
+
+	// The write-only key is only sent when its version changes, since
+	// Terraform cannot tell whether the value itself changed.
+	switch {
+	case apiKeyWO.IsNull():
+		apiKey := data.ApiKey.ValueString()
+		requestBody.ApiKey = &apiKey
+	case !data.ApiKeyWOVersion.Equal(state.ApiKeyWOVersion) || !state.ApiKey.IsNull():
+		apiKey := apiKeyWO.ValueString()
+		requestBody.ApiKey = &apiKey
 	}
 
 	apiResp, err := r.client.UpdateChatApiKeyWithResponse(ctx, id, requestBody)
@@ -250,6 +327,13 @@ func (r *ChatLLMProviderApiKeyResource) Update(ctx context.Context, req resource
 		}
 	}
 
+	if !data.ProfileIDs.IsNull() && !data.ProfileIDs.Equal(state.ProfileIDs) {
+		r.updateProfiles(ctx, id, data.ProfileIDs, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	readResp, err := r.client.GetChatApiKeyWithResponse(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read chat LLM provider API key after update, got error: %s", err))
@@ -304,3 +388,37 @@ func (r *ChatLLMProviderApiKeyResource) Delete(ctx context.Context, req resource
 func (r *ChatLLMProviderApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// updateProfiles replaces the set of profiles the API key is assigned to.
+func (r *ChatLLMProviderApiKeyResource) updateProfiles(ctx context.Context, id uuid.UUID, profileIDs types.Set, diags *diag.Diagnostics) {
+	var ids []string
+	diags.Append(profileIDs.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	requestBody := client.UpdateChatApiKeyProfilesJSONRequestBody{
+		ProfileIds: make([]uuid.UUID, len(ids)),
+	}
+	for i, profileID := range ids {
+		parsed, err := uuid.Parse(profileID)
+		if err != nil {
+			diags.AddAttributeError(path.Root("profile_ids"), "Invalid Profile ID", fmt.Sprintf("Unable to parse profile ID %q: %s", profileID, err))
+			return
+		}
+		requestBody.ProfileIds[i] = parsed
+	}
+
+	apiResp, err := r.client.UpdateChatApiKeyProfilesWithResponse(ctx, id, requestBody)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to update chat LLM provider API key profiles, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK when updating profiles, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccChatLLMProviderApiKeyResource(t *testing.T) {
@@ -90,6 +91,117 @@ func TestAccChatLLMProviderApiKeyResourceInvalidProvider(t *testing.T) {
 	})
 }
 
+func TestAccChatLLMProviderApiKeyResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChatLLMProviderApiKeyResourceWriteOnlyConfig("test-api-key-value", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_chat_llm_provider_api_key.test", "api_key_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("archestra_chat_llm_provider_api_key.test", "api_key_wo"),
+					resource.TestCheckNoResourceAttr("archestra_chat_llm_provider_api_key.test", "api_key"),
+				),
+			},
+			// Rotate the key by bumping the version
+			{
+				Config: testAccChatLLMProviderApiKeyResourceWriteOnlyConfig("rotated-api-key-value", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_chat_llm_provider_api_key.test", "api_key_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("archestra_chat_llm_provider_api_key.test", "api_key_wo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatLLMProviderApiKeyResourceMissingKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "archestra_chat_llm_provider_api_key" "test" {
+  name         = "Missing Key"
+  llm_provider = "openai"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccChatLLMProviderApiKeyResourceProfiles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChatLLMProviderApiKeyResourceProfilesConfig(`[archestra_profile.a.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_chat_llm_provider_api_key.test", "profile_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("archestra_chat_llm_provider_api_key.test", "profile_ids.*", "archestra_profile.a", "id"),
+				),
+			},
+			{
+				ResourceName:            "archestra_chat_llm_provider_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			{
+				Config: testAccChatLLMProviderApiKeyResourceProfilesConfig(`[archestra_profile.a.id, archestra_profile.b.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_chat_llm_provider_api_key.test", "profile_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("archestra_chat_llm_provider_api_key.test", "profile_ids.*", "archestra_profile.b", "id"),
+				),
+			},
+			{
+				Config: testAccChatLLMProviderApiKeyResourceProfilesConfig(`[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_chat_llm_provider_api_key.test", "profile_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccChatLLMProviderApiKeyResourceProfilesConfig(profileIDs string) string {
+	return fmt.Sprintf(`
+resource "archestra_profile" "a" {
+  name = "tf-acc-test-chat-key-profile-a"
+}
+
+resource "archestra_profile" "b" {
+  name = "tf-acc-test-chat-key-profile-b"
+}
+
+resource "archestra_chat_llm_provider_api_key" "test" {
+  name         = "Profile Scoped Key"
+  api_key      = "test-api-key-value"
+  llm_provider = "openai"
+  profile_ids  = %[1]s
+}
+`, profileIDs)
+}
+
+func testAccChatLLMProviderApiKeyResourceWriteOnlyConfig(apiKey string, version int) string {
+	return fmt.Sprintf(`
+resource "archestra_chat_llm_provider_api_key" "test" {
+  name               = "Write-only OpenAI Key"
+  api_key_wo         = %[1]q
+  api_key_wo_version = %[2]d
+  llm_provider       = "openai"
+}
+`, apiKey, version)
+}
+
 func testAccChatLLMProviderApiKeyResourceConfig(name string, llmProvider string, isDefault bool) string {
 	return fmt.Sprintf(`
 resource "archestra_chat_llm_provider_api_key" "test" {
@@ -8,11 +8,20 @@ import (
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -34,10 +43,19 @@ type MCPServerResource struct {
 }
 
 type MCPServerResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	DisplayName types.String `tfsdk:"display_name"`
-	MCPServerID types.String `tfsdk:"mcp_server_id"`
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	DisplayName          types.String `tfsdk:"display_name"`
+	MCPServerID          types.String `tfsdk:"mcp_server_id"`
+	EnvironmentValues    types.Map    `tfsdk:"environment_values"`
+	UserConfigValues     types.Map    `tfsdk:"user_config_values"`
+	AccessToken          types.String `tfsdk:"access_token"`
+	AccessTokenWO        types.String `tfsdk:"access_token_wo"`
+	AccessTokenWOVersion types.Int64  `tfsdk:"access_token_wo_version"`
+	SecretID             types.String `tfsdk:"secret_id"`
+	IsByosVault          types.Bool   `tfsdk:"is_byos_vault"`
+	TeamID               types.String `tfsdk:"team_id"`
+	ProfileIDs           types.Set    `tfsdk:"profile_ids"`
 }
 
 func (r *MCPServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,7 +64,8 @@ func (r *MCPServerResource) Metadata(ctx context.Context, req resource.MetadataR
 
 func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages an Archestra MCP server installation.",
+		MarkdownDescription: "Manages an Archestra MCP server installation. The API does not return `name`, `profile_ids` or the installation inputs, " +
+			"so they are null after import; the first apply after an import records them in state without reinstalling the server.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -60,7 +79,7 @@ func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "The name of the MCP server installation.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessImportedString(),
 				},
 			},
 			"display_name": schema.StringAttribute{
@@ -77,6 +96,93 @@ func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"environment_values": schema.MapAttribute{
+				MarkdownDescription: "Values for the catalog item's environment variables that are prompted on installation, keyed by variable name. " +
+					"Changing this reinstalls the server.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					requiresReplaceUnlessImportedMap(),
+				},
+			},
+			"user_config_values": schema.MapAttribute{
+				MarkdownDescription: "Values for the catalog item's user configuration fields, keyed by field name. Changing this reinstalls the server.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					requiresReplaceUnlessImportedMap(),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Access token for remote servers that authenticate with a bearer token. This value is stored in Terraform state; " +
+					"prefer `access_token_wo` where supported. Conflicts with `access_token_wo`. Changing this reinstalls the server.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token_wo")),
+				},
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImportedString(),
+				},
+			},
+			"access_token_wo": schema.StringAttribute{
+				MarkdownDescription: "Access token for remote servers, write-only and never stored in Terraform state. Requires Terraform 1.11 or later. " +
+					"Increment `access_token_wo_version` to reinstall the server with the current value",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token")),
+				},
+			},
+			"access_token_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `access_token_wo`. Changing it reinstalls the server with the current value of `access_token_wo`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("access_token_wo")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					requiresReplaceUnlessImportedInt64(),
+				},
+			},
+			"secret_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the secret holding the installation's credentials. Set it to reuse an existing secret; " +
+					"otherwise it is computed from the secret the server creates, if any.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"is_byos_vault": schema.BoolAttribute{
+				MarkdownDescription: "Whether `environment_values` and `user_config_values` are references to secrets in the team's external Vault folder " +
+					"(`path#key`) rather than literal values. Requires the `BYOS_VAULT` secrets backend. Changing this reinstalls the server.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					requiresReplaceUnlessImportedBool(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team to install the server for, shared with all team members. " +
+					"When omitted, the server is installed as a personal server of the API key's user. " +
+					"Installations cannot move between owners, so changing this reinstalls the server.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"profile_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of profiles to attach the server's tools to on installation. Changing this reinstalls the server; " +
+					"use `archestra_profile_tools` to manage tool assignments without reinstalling.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					requiresReplaceUnlessImportedSet(),
+				},
+			},
 		},
 	}
 }
@@ -119,6 +225,72 @@ func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateReque
 		requestBody.CatalogId = mcpServerID
 	}
 
+	if !data.EnvironmentValues.IsNull() {
+		environmentValues := make(map[string]string, len(data.EnvironmentValues.Elements()))
+		resp.Diagnostics.Append(data.EnvironmentValues.ElementsAs(ctx, &environmentValues, false)...)
+		requestBody.EnvironmentValues = &environmentValues
+	}
+
+	if !data.UserConfigValues.IsNull() {
+		userConfigValues := make(map[string]string, len(data.UserConfigValues.Elements()))
+		resp.Diagnostics.Append(data.UserConfigValues.ElementsAs(ctx, &userConfigValues, false)...)
+		requestBody.UserConfigValues = &userConfigValues
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attributes are only available in the configuration.
+	var accessTokenWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access_token_wo"), &accessTokenWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !data.AccessToken.IsNull():
+		requestBody.AccessToken = data.AccessToken.ValueStringPointer()
+	case !accessTokenWO.IsNull():
+		requestBody.AccessToken = accessTokenWO.ValueStringPointer()
+	}
+
+	if !data.SecretID.IsNull() && !data.SecretID.IsUnknown() {
+		secretID, err := uuid.Parse(data.SecretID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("secret_id"), "Invalid Secret ID", fmt.Sprintf("Unable to parse secret ID: %s", err))
+			return
+		}
+		requestBody.SecretId = &secretID
+	}
+
+	if !data.IsByosVault.IsNull() {
+		requestBody.IsByosVault = data.IsByosVault.ValueBoolPointer()
+	}
+
+	if !data.TeamID.IsNull() {
+		requestBody.TeamId = data.TeamID.ValueStringPointer()
+	}
+
+	if !data.ProfileIDs.IsNull() {
+		var profileIDs []string
+		resp.Diagnostics.Append(data.ProfileIDs.ElementsAs(ctx, &profileIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		agentIDs := make([]uuid.UUID, 0, len(profileIDs))
+		for _, profileID := range profileIDs {
+			agentID, err := uuid.Parse(profileID)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("profile_ids"), "Invalid Profile ID", fmt.Sprintf("Unable to parse profile ID %q: %s", profileID, err))
+				return
+			}
+			agentIDs = append(agentIDs, agentID)
+		}
+		requestBody.AgentIds = &agentIDs
+	}
+
 	// Call API
 	apiResp, err := r.client.InstallMcpServerWithResponse(ctx, requestBody)
 	if err != nil {
@@ -138,6 +310,11 @@ func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateReque
 	data.ID = types.StringValue(apiResp.JSON200.Id.String())
 	data.DisplayName = types.StringValue(apiResp.JSON200.Name)
 	data.MCPServerID = types.StringValue(apiResp.JSON200.CatalogId.String())
+	if apiResp.JSON200.SecretId != nil {
+		data.SecretID = types.StringValue(apiResp.JSON200.SecretId.String())
+	} else {
+		data.SecretID = types.StringNull()
+	}
 
 	if err := r.waitForServerTools(ctx, apiResp.JSON200.Id.String()); err != nil {
 		resp.Diagnostics.AddWarning(
@@ -189,18 +366,34 @@ func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Note: Keep user's configured name, set display_name to the API-returned name
 	data.DisplayName = types.StringValue(apiResp.JSON200.Name)
 	data.MCPServerID = types.StringValue(apiResp.JSON200.CatalogId.String())
+	if apiResp.JSON200.SecretId != nil {
+		data.SecretID = types.StringValue(apiResp.JSON200.SecretId.String())
+	} else {
+		data.SecretID = types.StringNull()
+	}
+
+	data.TeamID = types.StringPointerValue(apiResp.JSON200.TeamId)
+
+	// Installation inputs and profile_ids are not returned by the API, so
+	// they are kept as configured.
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// NOTE: The Archestra API does not support updating MCP servers.
-	// Updates will trigger resource replacement (delete + create).
-	// This function should never be called due to RequiresReplace plan modifiers on all attributes.
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"MCP server updates are not supported by the API. This should have triggered a replacement.",
-	)
+	// Changes to installed values trigger resource replacement (delete + create).
+	// The only in-place update is filling in values that are null since an
+	// import, which only needs to be recorded in state. Once recorded, later
+	// changes reinstall the server again.
+	var data MCPServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, mcpServerImportedPrivateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -236,6 +429,8 @@ func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteReque
 
 func (r *MCPServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, mcpServerImportedPrivateKey, []byte("true"))...)
 }
 
 func (r *MCPServerResource) waitForServerTools(ctx context.Context, serverID string) error {
@@ -291,3 +486,83 @@ func (r *MCPServerResource) checkServerToolsReady(ctx context.Context, serverID
 
 	return false, nil
 }
+
+// The API does not return the installation name or inputs, so they are null
+// in state after an import. ImportState marks the installation in private
+// state, and the requiresReplaceUnlessImported modifiers skip the reinstall
+// for values that are still null since the import. Update clears the mark;
+// as `name` is required and never read back, the first apply after an import
+// is always such an update.
+const (
+	mcpServerImportedPrivateKey = "imported"
+
+	requiresReplaceUnlessImportedDescription = "Changing this value reinstalls the server, unless it is being set for the first time since an import."
+)
+
+// privateStateGetter is implemented by the private state in plan modifier requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// importedValueIsNull reports whether the installation was imported and the
+// value has not been recorded in state since.
+func importedValueIsNull(ctx context.Context, private privateStateGetter, stateValue attr.Value, diags *diag.Diagnostics) bool {
+	if !stateValue.IsNull() {
+		return false
+	}
+
+	imported, d := private.GetKey(ctx, mcpServerImportedPrivateKey)
+	diags.Append(d...)
+
+	return len(imported) > 0
+}
+
+func requiresReplaceUnlessImportedString() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !importedValueIsNull(ctx, req.Private, req.StateValue, &resp.Diagnostics)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func requiresReplaceUnlessImportedMap() planmodifier.Map {
+	return mapplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !importedValueIsNull(ctx, req.Private, req.StateValue, &resp.Diagnostics)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func requiresReplaceUnlessImportedInt64() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !importedValueIsNull(ctx, req.Private, req.StateValue, &resp.Diagnostics)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func requiresReplaceUnlessImportedBool() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !importedValueIsNull(ctx, req.Private, req.StateValue, &resp.Diagnostics)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func requiresReplaceUnlessImportedSet() planmodifier.Set {
+	return setplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !importedValueIsNull(ctx, req.Private, req.StateValue, &resp.Diagnostics)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
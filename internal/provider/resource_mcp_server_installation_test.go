@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -94,6 +96,168 @@ func TestAccMCPServerInstallationResource(t *testing.T) {
 	})
 }
 
+func TestAccMCPServerInstallationResource_WithInputs(t *testing.T) {
+	sameID := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMCPServerInstallationResourceConfigWithInputs("test-installation-inputs", "first"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server_installation.test",
+						tfjsonpath.New("environment_values"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"API_TOKEN": knownvalue.StringExact("first"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server_installation.test",
+						tfjsonpath.New("display_name"),
+						knownvalue.NotNull(),
+					),
+					sameID.AddStateValue("archestra_mcp_server_installation.test", tfjsonpath.New("id")),
+				},
+			},
+			// Inputs are not returned by the API
+			{
+				ResourceName:            "archestra_mcp_server_installation.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "environment_values"},
+			},
+			// Replace the state with the imported one, where the inputs are null
+			{
+				ResourceName:       "archestra_mcp_server_installation.test",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			// Configured inputs are recorded in place instead of reinstalling
+			{
+				Config: testAccMCPServerInstallationResourceConfigWithInputs("test-installation-inputs", "first"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("archestra_mcp_server_installation.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameID.AddStateValue("archestra_mcp_server_installation.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				Config:             testAccMCPServerInstallationResourceConfigWithInputs("test-installation-inputs", "first"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Changing an input reinstalls the server
+			{
+				Config: testAccMCPServerInstallationResourceConfigWithInputs("test-installation-inputs", "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("archestra_mcp_server_installation.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server_installation.test",
+						tfjsonpath.New("environment_values"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"API_TOKEN": knownvalue.StringExact("second"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMCPServerInstallationResource_AddInputs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMCPServerInstallationResourceConfigWithoutInputs("test-installation-add-inputs"),
+			},
+			// Adding inputs to an installation that was not imported reinstalls it
+			{
+				Config: testAccMCPServerInstallationResourceConfigWithInputs("test-installation-add-inputs", "first"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("archestra_mcp_server_installation.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccMCPServerInstallationResource_TeamAndProfiles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMCPServerInstallationResourceConfigTeamAndProfiles("test-installation-team", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_mcp_server_installation.test", "team_id", "archestra_team.test", "id"),
+					resource.TestCheckResourceAttr("archestra_mcp_server_installation.test", "profile_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("archestra_mcp_server_installation.test", "profile_ids.*", "archestra_profile.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "archestra_mcp_server_installation.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "profile_ids"},
+			},
+			// Replace the state with the imported one, where profile_ids is null
+			{
+				ResourceName:       "archestra_mcp_server_installation.test",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			// The configured profiles are recorded in place instead of reinstalling
+			{
+				Config: testAccMCPServerInstallationResourceConfigTeamAndProfiles("test-installation-team", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("archestra_mcp_server_installation.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config:             testAccMCPServerInstallationResourceConfigTeamAndProfiles("test-installation-team", true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccMCPServerInstallationResource_AddProfiles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMCPServerInstallationResourceConfigTeamAndProfiles("test-installation-team", false),
+			},
+			// Attaching profiles to an installation that was not imported reinstalls it
+			{
+				Config: testAccMCPServerInstallationResourceConfigTeamAndProfiles("test-installation-team", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("archestra_mcp_server_installation.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccMCPServerResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "archestra_mcp_registry_catalog_item" "test" {
@@ -145,3 +309,83 @@ resource "archestra_mcp_server_installation" "test" {
 }
 `, name)
 }
+
+func testAccMCPServerInstallationResourceConfigWithoutInputs(name string) string {
+	return fmt.Sprintf(`
+resource "archestra_mcp_registry_catalog_item" "dependency" {
+  name        = "test-inputs-dependency-server"
+  description = "Dependency server for installation inputs test"
+  docs_url    = "https://github.com/example/dependency-server"
+
+  local_config = {
+    command   = "npx"
+    arguments = ["-y", "@modelcontextprotocol/server-filesystem", "/tmp"]
+  }
+}
+
+resource "archestra_mcp_server_installation" "test" {
+  name          = %[1]q
+  mcp_server_id = archestra_mcp_registry_catalog_item.dependency.id
+}
+`, name)
+}
+
+func testAccMCPServerInstallationResourceConfigWithInputs(name, token string) string {
+	return fmt.Sprintf(`
+resource "archestra_mcp_registry_catalog_item" "dependency" {
+  name        = "test-inputs-dependency-server"
+  description = "Dependency server for installation inputs test"
+  docs_url    = "https://github.com/example/dependency-server"
+
+  local_config = {
+    command   = "npx"
+    arguments = ["-y", "@modelcontextprotocol/server-filesystem", "/tmp"]
+  }
+}
+
+resource "archestra_mcp_server_installation" "test" {
+  name          = %[1]q
+  mcp_server_id = archestra_mcp_registry_catalog_item.dependency.id
+
+  environment_values = {
+    API_TOKEN = %[2]q
+  }
+}
+`, name, token)
+}
+
+func testAccMCPServerInstallationResourceConfigTeamAndProfiles(name string, withProfiles bool) string {
+	profileIDs := ""
+	if withProfiles {
+		profileIDs = "profile_ids   = [archestra_profile.test.id]"
+	}
+
+	return fmt.Sprintf(`
+resource "archestra_team" "test" {
+  name        = "test-installation-team"
+  description = "Team for installation test"
+}
+
+resource "archestra_profile" "test" {
+  name = "test-installation-profile"
+}
+
+resource "archestra_mcp_registry_catalog_item" "dependency" {
+  name        = "test-team-dependency-server"
+  description = "Dependency server for team installation test"
+  docs_url    = "https://github.com/example/dependency-server"
+
+  local_config = {
+    command   = "npx"
+    arguments = ["-y", "@modelcontextprotocol/server-filesystem", "/tmp"]
+  }
+}
+
+resource "archestra_mcp_server_installation" "test" {
+  name          = %[1]q
+  mcp_server_id = archestra_mcp_registry_catalog_item.dependency.id
+  team_id       = archestra_team.test.id
+  %[2]s
+}
+`, name, profileIDs)
+}
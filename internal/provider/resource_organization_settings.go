@@ -2,10 +2,19 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +28,20 @@ import (
 
 var _ resource.Resource = &OrganizationSettingsResource{}
 var _ resource.ResourceWithImportState = &OrganizationSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &OrganizationSettingsResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationSettingsResource{}
+
+// maxLogoFileSize is the largest logo_file accepted, before base64 encoding.
+const maxLogoFileSize = 1 << 20
+
+// logoMIMETypes are the image types accepted for logo_file.
+var logoMIMETypes = []string{
+	"image/gif",
+	"image/jpeg",
+	"image/png",
+	"image/svg+xml",
+	"image/webp",
+}
 
 func NewOrganizationSettingsResource() resource.Resource {
 	return &OrganizationSettingsResource{}
@@ -33,10 +56,15 @@ type OrganizationSettingsResourceModel struct {
 	Font                     types.String `tfsdk:"font"`
 	ColorTheme               types.String `tfsdk:"color_theme"`
 	Logo                     types.String `tfsdk:"logo"`
+	LogoFile                 types.String `tfsdk:"logo_file"`
+	LogoSHA256               types.String `tfsdk:"logo_sha256"`
 	LimitCleanupInterval     types.String `tfsdk:"limit_cleanup_interval"`
 	CompressionScope         types.String `tfsdk:"compression_scope"`
 	OnboardingComplete       types.Bool   `tfsdk:"onboarding_complete"`
 	ConvertToolResultsToToon types.Bool   `tfsdk:"convert_tool_results_to_toon"`
+	AutoConfigureNewTools    types.Bool   `tfsdk:"auto_configure_new_tools"`
+	HasLLMProxyLogs          types.Bool   `tfsdk:"has_llm_proxy_logs"`
+	HasMCPGatewayLogs        types.Bool   `tfsdk:"has_mcp_gateway_logs"`
 }
 
 func (r *OrganizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -117,8 +145,20 @@ func (r *OrganizationSettingsResource) Schema(ctx context.Context, req resource.
 				},
 			},
 			"logo": schema.StringAttribute{
-				MarkdownDescription: "Base64 encoded logo image for the organization",
+				MarkdownDescription: "Base64 encoded logo image for the organization. Conflicts with `logo_file`.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("logo_file")),
+				},
+			},
+			"logo_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a local logo image (PNG, JPEG, GIF, WebP or SVG, at most 1 MiB). The file is uploaded as a base64 data URI " +
+					"and changes are detected through `logo_sha256`, so the encoded image is never stored in `logo`. Conflicts with `logo`.",
+				Optional: true,
+			},
+			"logo_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hex digest of the logo data URI stored on the server",
+				Computed:            true,
 			},
 			"limit_cleanup_interval": schema.StringAttribute{
 				MarkdownDescription: "Interval for cleaning up usage limits. Valid values: 1h, 12h, 24h, 1w, 1m. Set to null to disable.",
@@ -157,10 +197,58 @@ func (r *OrganizationSettingsResource) Schema(ctx context.Context, req resource.
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"auto_configure_new_tools": schema.BoolAttribute{
+				MarkdownDescription: "Whether security policies are automatically configured for newly discovered tools",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"has_llm_proxy_logs": schema.BoolAttribute{
+				MarkdownDescription: "Whether the organization has sent traffic through the LLM proxy. Part of the onboarding status.",
+				Computed:            true,
+			},
+			"has_mcp_gateway_logs": schema.BoolAttribute{
+				MarkdownDescription: "Whether the organization has sent traffic through the MCP gateway. Part of the onboarding status.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
+func (r *OrganizationSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var logoFile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("logo_file"), &logoFile)...)
+	if resp.Diagnostics.HasError() || logoFile.IsNull() || logoFile.IsUnknown() {
+		return
+	}
+
+	if _, err := encodeLogoFile(logoFile.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("logo_file"), "Invalid Logo File", err.Error())
+	}
+}
+
+// ModifyPlan plans logo_sha256 from the configured logo, so that a changed
+// logo_file shows up as a diff even though its path stays the same.
+func (r *OrganizationSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan OrganizationSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logo, diags := r.plannedLogo(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("logo_sha256"), logoSHA256(logo))...)
+}
+
 func (r *OrganizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -187,7 +275,11 @@ func (r *OrganizationSettingsResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	requestBody := r.buildUpdateRequest(&data)
+	requestBody, diags := r.buildUpdateRequest(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	apiResp, err := r.client.UpdateOrganizationWithResponse(ctx, requestBody)
 	if err != nil {
@@ -205,6 +297,11 @@ func (r *OrganizationSettingsResource) Create(ctx context.Context, req resource.
 
 	r.mapResponseToModel(&data, apiResp)
 
+	resp.Diagnostics.Append(r.readOnboardingStatus(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -237,12 +334,9 @@ func (r *OrganizationSettingsResource) Read(ctx context.Context, req resource.Re
 	data.CompressionScope = types.StringValue(string(apiResp.JSON200.CompressionScope))
 	data.OnboardingComplete = types.BoolValue(apiResp.JSON200.OnboardingComplete)
 	data.ConvertToolResultsToToon = types.BoolValue(apiResp.JSON200.ConvertToolResultsToToon)
+	data.AutoConfigureNewTools = types.BoolValue(apiResp.JSON200.AutoConfigureNewTools)
 
-	if apiResp.JSON200.Logo != nil {
-		data.Logo = types.StringValue(*apiResp.JSON200.Logo)
-	} else {
-		data.Logo = types.StringNull()
-	}
+	r.mapLogo(&data, apiResp.JSON200.Logo)
 
 	if apiResp.JSON200.LimitCleanupInterval != nil {
 		data.LimitCleanupInterval = types.StringValue(string(*apiResp.JSON200.LimitCleanupInterval))
@@ -250,6 +344,11 @@ func (r *OrganizationSettingsResource) Read(ctx context.Context, req resource.Re
 		data.LimitCleanupInterval = types.StringNull()
 	}
 
+	resp.Diagnostics.Append(r.readOnboardingStatus(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -262,7 +361,11 @@ func (r *OrganizationSettingsResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	requestBody := r.buildUpdateRequest(&data)
+	requestBody, diags := r.buildUpdateRequest(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	apiResp, err := r.client.UpdateOrganizationWithResponse(ctx, requestBody)
 	if err != nil {
@@ -280,6 +383,11 @@ func (r *OrganizationSettingsResource) Update(ctx context.Context, req resource.
 
 	r.mapResponseToModel(&data, apiResp)
 
+	resp.Diagnostics.Append(r.readOnboardingStatus(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -292,9 +400,25 @@ func (r *OrganizationSettingsResource) ImportState(ctx context.Context, req reso
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *OrganizationSettingsResource) buildUpdateRequest(data *OrganizationSettingsResourceModel) client.UpdateOrganizationJSONRequestBody {
+func (r *OrganizationSettingsResource) buildUpdateRequest(data *OrganizationSettingsResourceModel) (client.UpdateOrganizationJSONRequestBody, diag.Diagnostics) {
 	requestBody := client.UpdateOrganizationJSONRequestBody{}
 
+	logo, diags := r.plannedLogo(data)
+	if diags.HasError() {
+		return requestBody, diags
+	}
+
+	// logo_file is read again at apply time, so make sure it still matches
+	// the digest that was planned.
+	if !data.LogoFile.IsNull() && !data.LogoSHA256.IsUnknown() && !logoSHA256(logo).Equal(data.LogoSHA256) {
+		diags.AddAttributeError(
+			path.Root("logo_file"),
+			"Logo File Changed",
+			fmt.Sprintf("The logo file %s changed after the plan was created. Run terraform plan again to upload the new logo.", data.LogoFile.ValueString()),
+		)
+		return requestBody, diags
+	}
+
 	if !data.Font.IsNull() && !data.Font.IsUnknown() {
 		font := client.UpdateOrganizationJSONBodyCustomFont(data.Font.ValueString())
 		requestBody.CustomFont = &font
@@ -305,9 +429,9 @@ func (r *OrganizationSettingsResource) buildUpdateRequest(data *OrganizationSett
 		requestBody.Theme = &theme
 	}
 
-	if !data.Logo.IsNull() && !data.Logo.IsUnknown() {
-		logo := data.Logo.ValueString()
-		requestBody.Logo = &logo
+	if !logo.IsNull() && !logo.IsUnknown() {
+		logoValue := logo.ValueString()
+		requestBody.Logo = &logoValue
 	}
 
 	if !data.LimitCleanupInterval.IsNull() && !data.LimitCleanupInterval.IsUnknown() {
@@ -330,7 +454,12 @@ func (r *OrganizationSettingsResource) buildUpdateRequest(data *OrganizationSett
 		requestBody.ConvertToolResultsToToon = &convert
 	}
 
-	return requestBody
+	if !data.AutoConfigureNewTools.IsNull() && !data.AutoConfigureNewTools.IsUnknown() {
+		autoConfigure := data.AutoConfigureNewTools.ValueBool()
+		requestBody.AutoConfigureNewTools = &autoConfigure
+	}
+
+	return requestBody, diags
 }
 
 func (r *OrganizationSettingsResource) mapResponseToModel(data *OrganizationSettingsResourceModel, org *client.UpdateOrganizationResponse) {
@@ -345,12 +474,9 @@ func (r *OrganizationSettingsResource) mapResponseToModel(data *OrganizationSett
 	data.CompressionScope = types.StringValue(string(resp.CompressionScope))
 	data.OnboardingComplete = types.BoolValue(resp.OnboardingComplete)
 	data.ConvertToolResultsToToon = types.BoolValue(resp.ConvertToolResultsToToon)
+	data.AutoConfigureNewTools = types.BoolValue(resp.AutoConfigureNewTools)
 
-	if resp.Logo != nil {
-		data.Logo = types.StringValue(*resp.Logo)
-	} else {
-		data.Logo = types.StringNull()
-	}
+	r.mapLogo(data, resp.Logo)
 
 	if resp.LimitCleanupInterval != nil {
 		data.LimitCleanupInterval = types.StringValue(string(*resp.LimitCleanupInterval))
@@ -358,3 +484,109 @@ func (r *OrganizationSettingsResource) mapResponseToModel(data *OrganizationSett
 		data.LimitCleanupInterval = types.StringNull()
 	}
 }
+
+// readOnboardingStatus populates the computed onboarding status attributes.
+func (r *OrganizationSettingsResource) readOnboardingStatus(ctx context.Context, data *OrganizationSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiResp, err := r.client.GetOnboardingStatusWithResponse(ctx)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read onboarding status, got error: %s", err))
+		return diags
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return diags
+	}
+
+	data.HasLLMProxyLogs = types.BoolValue(apiResp.JSON200.HasLlmProxyLogs)
+	data.HasMCPGatewayLogs = types.BoolValue(apiResp.JSON200.HasMcpGatewayLogs)
+
+	return diags
+}
+
+// plannedLogo returns the logo data URI to send, reading it from logo_file
+// when that is set.
+func (r *OrganizationSettingsResource) plannedLogo(data *OrganizationSettingsResourceModel) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.LogoFile.IsUnknown() {
+		return types.StringUnknown(), diags
+	}
+
+	if data.LogoFile.IsNull() {
+		return data.Logo, diags
+	}
+
+	logo, err := encodeLogoFile(data.LogoFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("logo_file"), "Invalid Logo File", err.Error())
+		return types.StringUnknown(), diags
+	}
+
+	return types.StringValue(logo), diags
+}
+
+// mapLogo sets logo and logo_sha256 from the server's logo. When logo_file
+// is in use, logo is left null so the encoded image stays out of state.
+func (r *OrganizationSettingsResource) mapLogo(data *OrganizationSettingsResourceModel, apiLogo *string) {
+	logo := types.StringPointerValue(apiLogo)
+
+	data.LogoSHA256 = logoSHA256(logo)
+
+	if data.LogoFile.IsNull() {
+		data.Logo = logo
+	} else {
+		data.Logo = types.StringNull()
+	}
+}
+
+// encodeLogoFile reads an image file and returns it as a base64 data URI.
+func encodeLogoFile(name string) (string, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return "", fmt.Errorf("unable to read logo file: %w", err)
+	}
+
+	if info.Size() > maxLogoFileSize {
+		return "", fmt.Errorf("logo file %s is %d bytes, the maximum is %d bytes", name, info.Size(), maxLogoFileSize)
+	}
+
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", fmt.Errorf("unable to read logo file: %w", err)
+	}
+
+	mimeType, _, _ := strings.Cut(http.DetectContentType(content), ";")
+
+	// SVG is text, so content sniffing cannot tell it apart from other XML.
+	if (mimeType == "text/xml" || mimeType == "text/plain") && strings.EqualFold(filepath.Ext(name), ".svg") {
+		mimeType = "image/svg+xml"
+	}
+
+	if !slices.Contains(logoMIMETypes, mimeType) {
+		return "", fmt.Errorf("logo file %s has unsupported type %s, expected one of: %s", name, mimeType, strings.Join(logoMIMETypes, ", "))
+	}
+
+	return "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(content), nil
+}
+
+// logoSHA256 returns the hex SHA-256 digest of a logo, or null when there is
+// no logo.
+func logoSHA256(logo types.String) types.String {
+	if logo.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	if logo.IsNull() {
+		return types.StringNull()
+	}
+
+	sum := sha256.Sum256([]byte(logo.ValueString()))
+
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
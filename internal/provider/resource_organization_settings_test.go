@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccOrganizationSettingsResource(t *testing.T) {
@@ -63,6 +71,29 @@ func TestAccOrganizationSettingsResourceWithLimitCleanup(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationSettingsResourceAutoConfigureNewTools(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationSettingsResourceConfigAutoConfigure(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_organization_settings.test", "auto_configure_new_tools", "true"),
+					resource.TestCheckResourceAttrSet("archestra_organization_settings.test", "has_llm_proxy_logs"),
+					resource.TestCheckResourceAttrSet("archestra_organization_settings.test", "has_mcp_gateway_logs"),
+				),
+			},
+			{
+				Config: testAccOrganizationSettingsResourceConfigAutoConfigure(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_organization_settings.test", "auto_configure_new_tools", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccOrganizationSettingsResourceConfig(font, theme, scope string, onboarding, convert bool) string {
 	onboardingStr := "false"
 	if onboarding {
@@ -93,6 +124,20 @@ resource "archestra_organization_settings" "test" {
 `
 }
 
+func testAccOrganizationSettingsResourceConfigAutoConfigure(autoConfigure bool) string {
+	autoConfigureStr := "false"
+	if autoConfigure {
+		autoConfigureStr = "true"
+	}
+
+	return `
+resource "archestra_organization_settings" "test" {
+  onboarding_complete      = true
+  auto_configure_new_tools = ` + autoConfigureStr + `
+}
+`
+}
+
 func TestAccOrganizationSettingsResourceInvalidFont(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -167,6 +212,98 @@ func TestAccOrganizationSettingsResourceWithLogo(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationSettingsResourceWithLogoFile(t *testing.T) {
+	logoFile := filepath.Join(t.TempDir(), "logo.png")
+	firstLogo := "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+	secondLogo := "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg=="
+
+	logoDigestChanges := statecheck.CompareValue(compare.ValuesDiffer())
+
+	writeLogo := func(encoded string) func() {
+		return func() {
+			content, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(logoFile, content, 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: writeLogo(firstLogo),
+				Config:    testAccOrganizationSettingsResourceConfigWithLogoFile(logoFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_organization_settings.test", "logo_file", logoFile),
+					resource.TestCheckNoResourceAttr("archestra_organization_settings.test", "logo"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// SHA-256 of "data:image/png;base64," followed by firstLogo
+					statecheck.ExpectKnownValue(
+						"archestra_organization_settings.test",
+						tfjsonpath.New("logo_sha256"),
+						knownvalue.StringExact("bc9b95d56518f5a1bae581992072b01c58749feb5c37d6198a7da377e58dc7a3"),
+					),
+					logoDigestChanges.AddStateValue("archestra_organization_settings.test", tfjsonpath.New("logo_sha256")),
+				},
+			},
+			{
+				// Same path, new content: the digest must change.
+				PreConfig: writeLogo(secondLogo),
+				Config:    testAccOrganizationSettingsResourceConfigWithLogoFile(logoFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("archestra_organization_settings.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(
+							"archestra_organization_settings.test",
+							tfjsonpath.New("logo_sha256"),
+							knownvalue.StringExact("53f01d18fd6a95d0d6c5890b5f790ef5feda33980e6165ae2d58ebe46b35d363"),
+						),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_organization_settings.test", "logo_file", logoFile),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					logoDigestChanges.AddStateValue("archestra_organization_settings.test", tfjsonpath.New("logo_sha256")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccOrganizationSettingsResourceInvalidLogoFile(t *testing.T) {
+	logoFile := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(logoFile, []byte("not an image"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationSettingsResourceConfigWithLogoFile(logoFile),
+				ExpectError: regexp.MustCompile(`unsupported type`),
+			},
+		},
+	})
+}
+
+func testAccOrganizationSettingsResourceConfigWithLogoFile(logoFile string) string {
+	return `
+resource "archestra_organization_settings" "test" {
+  onboarding_complete = true
+  logo_file           = "` + logoFile + `"
+}
+`
+}
+
 func testAccOrganizationSettingsResourceConfigInvalidFont() string {
 	return `
 resource "archestra_organization_settings" "test" {
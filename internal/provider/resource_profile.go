@@ -3,12 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,14 +23,22 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProfileResource{}
 var _ resource.ResourceWithImportState = &ProfileResource{}
+var _ resource.ResourceWithValidateConfig = &ProfileResource{}
 
 func NewProfileResource() resource.Resource {
 	return &ProfileResource{}
 }
 
+// newProfileResource returns a profile resource bound to the provider's
+// allowed_label_keys setting, which is only known once the provider is configured.
+func (p *ArchestraProvider) newProfileResource() resource.Resource {
+	return &ProfileResource{allowedLabelKeys: p.allowedLabelKeys}
+}
+
 // ProfileResource defines the resource implementation.
 type ProfileResource struct {
-	client *client.ClientWithResponses
+	client           *client.ClientWithResponses
+	allowedLabelKeys []string
 }
 
 // ProfileLabelModel describes a label data model.
@@ -36,9 +49,13 @@ type ProfileLabelModel struct {
 
 // ProfileResourceModel describes the resource data model.
 type ProfileResourceModel struct {
-	ID     types.String        `tfsdk:"id"`
-	Name   types.String        `tfsdk:"name"`
-	Labels []ProfileLabelModel `tfsdk:"labels"`
+	ID                       types.String        `tfsdk:"id"`
+	Name                     types.String        `tfsdk:"name"`
+	Labels                   []ProfileLabelModel `tfsdk:"labels"`
+	Teams                    types.Set           `tfsdk:"teams"`
+	ConsiderContextUntrusted types.Bool          `tfsdk:"consider_context_untrusted"`
+	IsDefault                types.Bool          `tfsdk:"is_default"`
+	IsDemo                   types.Bool          `tfsdk:"is_demo"`
 }
 
 func (r *ProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -77,6 +94,32 @@ func (r *ProfileResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"teams": schema.SetAttribute{
+				MarkdownDescription: "IDs of the teams that have access to the profile. When set, this list is authoritative and " +
+					"team grants made elsewhere are reverted; leave it unset to manage access outside Terraform",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"consider_context_untrusted": schema.BoolAttribute{
+				MarkdownDescription: "Whether all context passed to the profile is treated as untrusted data (default: false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this profile is the organization default profile. Leave unset when the default is managed with `archestra_profile_default`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_demo": schema.BoolAttribute{
+				MarkdownDescription: "Whether this profile is a demo profile (default: false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -100,6 +143,37 @@ func (r *ProfileResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *ProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Nothing to check until the provider is configured with allowed keys
+	if len(r.allowedLabelKeys) == 0 {
+		return
+	}
+
+	var labelsValue types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("labels"), &labelsValue)...)
+	if resp.Diagnostics.HasError() || labelsValue.IsNull() || labelsValue.IsUnknown() {
+		return
+	}
+
+	var labels []ProfileLabelModel
+	resp.Diagnostics.Append(labelsValue.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, label := range labels {
+		if label.Key.IsNull() || label.Key.IsUnknown() || slices.Contains(r.allowedLabelKeys, label.Key.ValueString()) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("labels").AtListIndex(i).AtName("key"),
+			"Label Key Not Allowed",
+			fmt.Sprintf("Label key %q is not in the provider's allowed_label_keys: %s", label.Key.ValueString(), strings.Join(r.allowedLabelKeys, ", ")),
+		)
+	}
+}
+
 func (r *ProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ProfileResourceModel
 
@@ -129,11 +203,25 @@ func (r *ProfileResource) Create(ctx context.Context, req resource.CreateRequest
 		})
 	}
 
+	// Teams are required by the API, so send an empty array when unset
+	teams := []string{}
+	if !data.Teams.IsNull() {
+		resp.Diagnostics.Append(data.Teams.ElementsAs(ctx, &teams, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Create request body using generated type
 	requestBody := client.CreateAgentJSONRequestBody{
-		Name:   data.Name.ValueString(),
-		Teams:  []string{}, // Empty teams array (required by API)
-		Labels: &labels,
+		Name:                     data.Name.ValueString(),
+		Teams:                    teams,
+		Labels:                   &labels,
+		ConsiderContextUntrusted: data.ConsiderContextUntrusted.ValueBoolPointer(),
+		IsDemo:                   data.IsDemo.ValueBoolPointer(),
+	}
+	if !data.IsDefault.IsUnknown() {
+		requestBody.IsDefault = data.IsDefault.ValueBoolPointer()
 	}
 
 	// Call API
@@ -155,6 +243,14 @@ func (r *ProfileResource) Create(ctx context.Context, req resource.CreateRequest
 	// Map response to Terraform state
 	data.ID = types.StringValue(apiResp.JSON200.Id.String())
 	data.Name = types.StringValue(apiResp.JSON200.Name)
+	data.ConsiderContextUntrusted = types.BoolValue(apiResp.JSON200.ConsiderContextUntrusted)
+	data.IsDefault = types.BoolValue(apiResp.JSON200.IsDefault)
+	data.IsDemo = types.BoolValue(apiResp.JSON200.IsDemo)
+
+	// If teams were not specified in config (null), keep them null in state
+	if !data.Teams.IsNull() {
+		resp.Diagnostics.Append(r.mapTeams(ctx, &data, apiResp.JSON200.Teams)...)
+	}
 
 	// Map labels from API response, preserving configuration order
 	// If labels were not specified in config (nil), keep them nil in state
@@ -203,8 +299,14 @@ func (r *ProfileResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// Name is only null in state when the resource is being imported
+	importing := data.Name.IsNull()
+
 	// Map response to Terraform state
 	data.Name = types.StringValue(apiResp.JSON200.Name)
+	data.ConsiderContextUntrusted = types.BoolValue(apiResp.JSON200.ConsiderContextUntrusted)
+	data.IsDefault = types.BoolValue(apiResp.JSON200.IsDefault)
+	data.IsDemo = types.BoolValue(apiResp.JSON200.IsDemo)
 
 	// Map labels from API response, preserving existing state order
 	// If labels were not specified in state (nil), keep them nil
@@ -212,6 +314,11 @@ func (r *ProfileResource) Read(ctx context.Context, req resource.ReadRequest, re
 		data.Labels = r.mapLabelsToConfigurationOrder(data.Labels, apiResp.JSON200.Labels)
 	}
 
+	// Only track teams when they are managed, or when importing a profile that has any
+	if !data.Teams.IsNull() || (importing && len(apiResp.JSON200.Teams) > 0) {
+		resp.Diagnostics.Append(r.mapTeams(ctx, &data, apiResp.JSON200.Teams)...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -254,8 +361,31 @@ func (r *ProfileResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Create request body using generated type
 	name := data.Name.ValueString()
 	requestBody := client.UpdateAgentJSONRequestBody{
-		Name:   &name,
-		Labels: &labels,
+		Name:                     &name,
+		Labels:                   &labels,
+		ConsiderContextUntrusted: data.ConsiderContextUntrusted.ValueBoolPointer(),
+		IsDemo:                   data.IsDemo.ValueBoolPointer(),
+	}
+
+	// Only send is_default when configured, so a default designated elsewhere
+	// is not taken back by an unrelated update to this profile
+	var isDefault types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_default"), &isDefault)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !isDefault.IsNull() {
+		requestBody.IsDefault = isDefault.ValueBoolPointer()
+	}
+
+	// Leave team grants untouched unless they are managed by Terraform
+	if !data.Teams.IsNull() {
+		teams := []string{}
+		resp.Diagnostics.Append(data.Teams.ElementsAs(ctx, &teams, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestBody.Teams = &teams
 	}
 
 	// Call API
@@ -277,9 +407,17 @@ func (r *ProfileResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Map response to Terraform state
 	data.Name = types.StringValue(apiResp.JSON200.Name)
 
+	data.ConsiderContextUntrusted = types.BoolValue(apiResp.JSON200.ConsiderContextUntrusted)
+	data.IsDefault = types.BoolValue(apiResp.JSON200.IsDefault)
+	data.IsDemo = types.BoolValue(apiResp.JSON200.IsDemo)
+
 	// Map labels from API response, preserving configuration order
 	data.Labels = r.mapLabelsToConfigurationOrder(data.Labels, apiResp.JSON200.Labels)
 
+	if !data.Teams.IsNull() {
+		resp.Diagnostics.Append(r.mapTeams(ctx, &data, apiResp.JSON200.Teams)...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -351,3 +489,18 @@ func (r *ProfileResource) mapLabelsToConfigurationOrder(configLabels []ProfileLa
 
 	return result
 }
+
+// mapTeams sets the teams attribute from the team IDs in an API response.
+func (r *ProfileResource) mapTeams(ctx context.Context, data *ProfileResourceModel, apiTeams []struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}) diag.Diagnostics {
+	teamIDs := make([]string, 0, len(apiTeams))
+	for _, team := range apiTeams {
+		teamIDs = append(teamIDs, team.Id)
+	}
+
+	var diags diag.Diagnostics
+	data.Teams, diags = types.SetValueFrom(ctx, types.StringType, teamIDs)
+	return diags
+}
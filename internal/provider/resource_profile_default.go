@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// profileDefaultID is the fixed identifier of the organization-wide default profile designation.
+const profileDefaultID = "default_profile"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProfileDefaultResource{}
+var _ resource.ResourceWithImportState = &ProfileDefaultResource{}
+
+func NewProfileDefaultResource() resource.Resource {
+	return &ProfileDefaultResource{}
+}
+
+// ProfileDefaultResource defines the resource implementation.
+type ProfileDefaultResource struct {
+	client *client.ClientWithResponses
+}
+
+// ProfileDefaultResourceModel describes the resource data model.
+type ProfileDefaultResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProfileID types.String `tfsdk:"profile_id"`
+}
+
+func (r *ProfileDefaultResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profile_default"
+}
+
+func (r *ProfileDefaultResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Designates the organization default profile. This is a singleton resource. " +
+			"Switching `profile_id` moves the default in a single step, so the organization never has zero or two default profiles. " +
+			"Do not also set `is_default` on the `archestra_profile` resources involved. " +
+			"Can be imported with the ID `" + profileDefaultID + "`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the default profile designation (always `" + profileDefaultID + "`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the profile to use as the organization default",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ProfileDefaultResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProfileDefaultResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProfileDefaultResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setDefault(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileDefaultResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProfileDefaultResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetDefaultAgentWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read default profile, got error: %s", err))
+		return
+	}
+
+	// The organization no longer has a default profile
+	if apiResp.JSON404 != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(profileDefaultID)
+	data.ProfileID = types.StringValue(apiResp.JSON200.Id.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileDefaultResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProfileDefaultResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Marking a profile as default clears the flag on the previous default
+	// server-side, so the old profile is deliberately not unset first.
+	r.setDefault(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileDefaultResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProfileDefaultResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID, err := uuid.Parse(data.ProfileID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	isDefault := false
+	apiResp, err := r.client.UpdateAgentWithResponse(ctx, profileID, client.UpdateAgentJSONRequestBody{
+		IsDefault: &isDefault,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to unset default profile, got error: %s", err))
+		return
+	}
+
+	// Check response (200 or 404 are both acceptable for delete)
+	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+}
+
+func (r *ProfileDefaultResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setDefault marks the configured profile as the organization default.
+func (r *ProfileDefaultResource) setDefault(ctx context.Context, data *ProfileDefaultResourceModel, diags *diag.Diagnostics) {
+	profileID, err := uuid.Parse(data.ProfileID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile_id"), "Invalid Profile ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	isDefault := true
+	apiResp, err := r.client.UpdateAgentWithResponse(ctx, profileID, client.UpdateAgentJSONRequestBody{
+		IsDefault: &isDefault,
+	})
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to set default profile, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON404 != nil {
+		diags.AddAttributeError(path.Root("profile_id"), "Profile Not Found", fmt.Sprintf("Profile with ID %s not found", profileID))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK when setting default, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(profileDefaultID)
+	data.ProfileID = types.StringValue(apiResp.JSON200.Id.String())
+}
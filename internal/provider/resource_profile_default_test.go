@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProfileDefaultResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProfileDefaultResourceConfig("primary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_profile_default.test", "id", "default_profile"),
+					resource.TestCheckResourceAttrPair("archestra_profile_default.test", "profile_id", "archestra_profile.primary", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_profile_default.test",
+				ImportState:       true,
+				ImportStateId:     "default_profile",
+				ImportStateVerify: true,
+			},
+			// Move the default to the other profile
+			{
+				Config: testAccProfileDefaultResourceConfig("secondary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_profile_default.test", "profile_id", "archestra_profile.secondary", "id"),
+					resource.TestCheckResourceAttrPair("data.archestra_default_profile.current", "id", "archestra_profile.secondary", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProfileDefaultResourceConfig(defaultProfile string) string {
+	return fmt.Sprintf(`
+resource "archestra_profile" "primary" {
+  name = "tf-acc-test-default-profile-primary"
+}
+
+resource "archestra_profile" "secondary" {
+  name = "tf-acc-test-default-profile-secondary"
+}
+
+resource "archestra_profile_default" "test" {
+  profile_id = archestra_profile.%[1]s.id
+}
+
+data "archestra_default_profile" "current" {
+  depends_on = [archestra_profile_default.test]
+}
+`, defaultProfile)
+}
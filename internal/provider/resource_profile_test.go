@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -189,3 +190,115 @@ resource "archestra_profile" "nolabels" {
 }
 `, name)
 }
+
+func TestAccProfileResource_WithTeams(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create team-scoped profile with untrusted context
+			{
+				Config: testAccProfileResourceConfigTeams(`[archestra_team.a.id]`, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_profile.teams",
+						tfjsonpath.New("teams"),
+						knownvalue.SetSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_profile.teams",
+						tfjsonpath.New("consider_context_untrusted"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_profile.teams",
+						tfjsonpath.New("is_demo"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_profile.teams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Grant a second team and trust context again
+			{
+				Config: testAccProfileResourceConfigTeams(`[archestra_team.a.id, archestra_team.b.id]`, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_profile.teams",
+						tfjsonpath.New("teams"),
+						knownvalue.SetSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_profile.teams",
+						tfjsonpath.New("consider_context_untrusted"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProfileResourceConfigTeams(teams string, untrusted bool) string {
+	return fmt.Sprintf(`
+resource "archestra_team" "a" {
+  name = "tf-acc-test-profile-team-a"
+}
+
+resource "archestra_team" "b" {
+  name = "tf-acc-test-profile-team-b"
+}
+
+resource "archestra_profile" "teams" {
+  name                       = "test-profile-teams"
+  teams                      = %[1]s
+  consider_context_untrusted = %[2]t
+}
+`, teams, untrusted)
+}
+
+func TestAccProfileResource_AllowedLabelKeys(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProfileResourceConfigAllowedLabelKeys("enviroment"),
+				ExpectError: regexp.MustCompile(`Label Key Not Allowed`),
+			},
+			{
+				Config: testAccProfileResourceConfigAllowedLabelKeys("environment"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_profile.allowed",
+						tfjsonpath.New("labels").AtSliceIndex(0).AtMapKey("key"),
+						knownvalue.StringExact("environment"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProfileResourceConfigAllowedLabelKeys(key string) string {
+	return fmt.Sprintf(`
+provider "archestra" {
+  allowed_label_keys = ["environment", "team"]
+}
+
+resource "archestra_profile" "allowed" {
+  name = "test-profile-allowed-label-keys"
+
+  labels = [
+    {
+      key   = %[1]q
+      value = "production"
+    }
+  ]
+}
+`, key)
+}
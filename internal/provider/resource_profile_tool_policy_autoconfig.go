@@ -0,0 +1,523 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProfileToolPolicyAutoconfigResource{}
+
+func NewProfileToolPolicyAutoconfigResource() resource.Resource {
+	return &ProfileToolPolicyAutoconfigResource{}
+}
+
+// ProfileToolPolicyAutoconfigResource defines the resource implementation.
+type ProfileToolPolicyAutoconfigResource struct {
+	client *client.ClientWithResponses
+}
+
+// AutoconfiguredTrustedDataPolicyModel describes a generated trusted data policy.
+type AutoconfiguredTrustedDataPolicyModel struct {
+	ID            types.String `tfsdk:"id"`
+	Description   types.String `tfsdk:"description"`
+	AttributePath types.String `tfsdk:"attribute_path"`
+	Operator      types.String `tfsdk:"operator"`
+	Value         types.String `tfsdk:"value"`
+	Action        types.String `tfsdk:"action"`
+}
+
+// AutoconfiguredToolInvocationPolicyModel describes a generated tool invocation policy.
+type AutoconfiguredToolInvocationPolicyModel struct {
+	ID           types.String `tfsdk:"id"`
+	ArgumentName types.String `tfsdk:"argument_name"`
+	Operator     types.String `tfsdk:"operator"`
+	Value        types.String `tfsdk:"value"`
+	Action       types.String `tfsdk:"action"`
+	Reason       types.String `tfsdk:"reason"`
+}
+
+// AutoconfiguredToolModel describes the auto-configuration outcome for a single tool.
+type AutoconfiguredToolModel struct {
+	ToolID                               types.String                              `tfsdk:"tool_id"`
+	ProfileToolID                        types.String                              `tfsdk:"profile_tool_id"`
+	ToolResultTreatment                  types.String                              `tfsdk:"tool_result_treatment"`
+	AllowUsageWhenUntrustedDataIsPresent types.Bool                                `tfsdk:"allow_usage_when_untrusted_data_is_present"`
+	Reasoning                            types.String                              `tfsdk:"reasoning"`
+	PoliciesAutoConfiguredAt             types.String                              `tfsdk:"policies_auto_configured_at"`
+	TrustedDataPolicies                  []AutoconfiguredTrustedDataPolicyModel    `tfsdk:"trusted_data_policies"`
+	ToolInvocationPolicies               []AutoconfiguredToolInvocationPolicyModel `tfsdk:"tool_invocation_policies"`
+}
+
+// ProfileToolPolicyAutoconfigResourceModel describes the resource data model.
+type ProfileToolPolicyAutoconfigResourceModel struct {
+	ID             types.String              `tfsdk:"id"`
+	ProfileID      types.String              `tfsdk:"profile_id"`
+	ToolIDs        types.Set                 `tfsdk:"tool_ids"`
+	Triggers       types.Map                 `tfsdk:"triggers"`
+	PromptTemplate types.String              `tfsdk:"prompt_template"`
+	Tools          []AutoconfiguredToolModel `tfsdk:"tools"`
+}
+
+func (r *ProfileToolPolicyAutoconfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profile_tool_policy_autoconfig"
+}
+
+func (r *ProfileToolPolicyAutoconfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	trustedDataPolicyAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Trusted data policy identifier",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the policy",
+			Computed:            true,
+		},
+		"attribute_path": schema.StringAttribute{
+			MarkdownDescription: "Path of the tool result attribute the policy inspects",
+			Computed:            true,
+		},
+		"operator": schema.StringAttribute{
+			MarkdownDescription: "Comparison operator",
+			Computed:            true,
+		},
+		"value": schema.StringAttribute{
+			MarkdownDescription: "Value compared against",
+			Computed:            true,
+		},
+		"action": schema.StringAttribute{
+			MarkdownDescription: "Action taken when the policy matches",
+			Computed:            true,
+		},
+	}
+
+	toolInvocationPolicyAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Tool invocation policy identifier",
+			Computed:            true,
+		},
+		"argument_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the tool argument the policy inspects",
+			Computed:            true,
+		},
+		"operator": schema.StringAttribute{
+			MarkdownDescription: "Comparison operator",
+			Computed:            true,
+		},
+		"value": schema.StringAttribute{
+			MarkdownDescription: "Value compared against",
+			Computed:            true,
+		},
+		"action": schema.StringAttribute{
+			MarkdownDescription: "Action taken when the policy matches",
+			Computed:            true,
+		},
+		"reason": schema.StringAttribute{
+			MarkdownDescription: "Reason reported when the policy blocks an invocation",
+			Computed:            true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Asks Archestra to auto-configure security settings and policies for tools assigned to a profile. " +
+			"Auto-configuration runs when the resource is created and again whenever `tool_ids` or `triggers` change. " +
+			"The generated settings and policies are exposed in `tools` so they can be reviewed in plan output or copied into " +
+			"`archestra_profile_tool`, `archestra_trusted_data_policy` and `archestra_tool_invocation_policy` resources. " +
+			"Destroying this resource leaves the generated policies in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the auto-configuration (same as `profile_id`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the profile the tools are assigned to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tool_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the tools to auto-configure. Every tool must already be assigned to the profile",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that, when changed, run auto-configuration again",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"prompt_template": schema.StringAttribute{
+				MarkdownDescription: "Prompt template the policy configuration subagent used",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tools": schema.ListNestedAttribute{
+				MarkdownDescription: "Auto-configured settings and generated policies for each tool",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tool_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the tool",
+							Computed:            true,
+						},
+						"profile_tool_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the profile-tool assignment, as used by policy resources",
+							Computed:            true,
+						},
+						"tool_result_treatment": schema.StringAttribute{
+							MarkdownDescription: "How tool results are treated (trusted, sanitize_with_dual_llm, untrusted)",
+							Computed:            true,
+						},
+						"allow_usage_when_untrusted_data_is_present": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tool may be used when untrusted data is present",
+							Computed:            true,
+						},
+						"reasoning": schema.StringAttribute{
+							MarkdownDescription: "Explanation of the generated configuration",
+							Computed:            true,
+						},
+						"policies_auto_configured_at": schema.StringAttribute{
+							MarkdownDescription: "When the tool's policies were last auto-configured (RFC 3339)",
+							Computed:            true,
+						},
+						"trusted_data_policies": schema.ListNestedAttribute{
+							MarkdownDescription: "Trusted data policies attached to the tool",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: trustedDataPolicyAttributes,
+							},
+						},
+						"tool_invocation_policies": schema.ListNestedAttribute{
+							MarkdownDescription: "Tool invocation policies attached to the tool",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: toolInvocationPolicyAttributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ProfileToolPolicyAutoconfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProfileToolPolicyAutoconfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProfileToolPolicyAutoconfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID, err := uuid.Parse(data.ProfileID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile_id"), "Invalid Profile ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	var toolIDs []string
+	resp.Diagnostics.Append(data.ToolIDs.ElementsAs(ctx, &toolIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignments, err := listProfileTools(ctx, r.client, profileID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read profile tools, got error: %s", err))
+		return
+	}
+
+	profileToolIDs := make(map[string]openapi_types.UUID, len(assignments))
+	for _, assignment := range assignments {
+		profileToolIDs[assignment.ToolID] = assignment.ID
+	}
+
+	body := client.AutoConfigureAgentToolPoliciesJSONRequestBody{
+		AgentToolIds: make([]openapi_types.UUID, 0, len(toolIDs)),
+	}
+	for _, toolID := range toolIDs {
+		profileToolID, ok := profileToolIDs[toolID]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tool_ids"),
+				"Tool Not Assigned",
+				fmt.Sprintf("Tool %s is not assigned to profile %s", toolID, profileID),
+			)
+			continue
+		}
+		body.AgentToolIds = append(body.AgentToolIds, profileToolID)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promptResp, err := r.client.GetPolicyConfigSubagentPromptWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read policy configuration prompt, got error: %s", err))
+		return
+	}
+
+	if promptResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("GetPolicyConfigSubagentPrompt: Expected 200 OK, got status %d", promptResp.StatusCode()),
+		)
+		return
+	}
+
+	data.PromptTemplate = types.StringValue(promptResp.JSON200.PromptTemplate)
+
+	apiResp, err := r.client.AutoConfigureAgentToolPoliciesWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to auto-configure tool policies, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	var failures []string
+	for _, result := range apiResp.JSON200.Results {
+		if result.Success {
+			continue
+		}
+
+		message := "unknown error"
+		if result.Error != nil {
+			message = *result.Error
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", result.AgentToolId, message))
+	}
+
+	if len(failures) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tool_ids"),
+			"Auto-configuration Failed",
+			fmt.Sprintf("Unable to auto-configure %d tool(s) on profile %s:\n%s", len(failures), profileID, strings.Join(failures, "\n")),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(profileID.String())
+
+	r.readTools(ctx, profileID, toolIDs, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolPolicyAutoconfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProfileToolPolicyAutoconfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	// Remove from state if the profile itself is gone
+	profileResp, err := r.client.GetAgentWithResponse(ctx, profileID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read profile, got error: %s", err))
+		return
+	}
+
+	if profileResp.JSON404 != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var toolIDs []string
+	resp.Diagnostics.Append(data.ToolIDs.ElementsAs(ctx, &toolIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readTools(ctx, profileID, toolIDs, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolPolicyAutoconfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so Update is never called.
+	var data ProfileToolPolicyAutoconfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolPolicyAutoconfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Generated settings and policies are left in place. Removing from Terraform state only.
+}
+
+// readTools refreshes the auto-configured settings and generated policies of the given tools.
+// Tools that are no longer assigned to the profile are omitted.
+func (r *ProfileToolPolicyAutoconfigResource) readTools(ctx context.Context, profileID uuid.UUID, toolIDs []string, data *ProfileToolPolicyAutoconfigResourceModel, diags *diag.Diagnostics) {
+	assignments, err := listProfileTools(ctx, r.client, profileID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read profile tools, got error: %s", err))
+		return
+	}
+
+	trustedResp, err := r.client.GetTrustedDataPoliciesWithResponse(ctx)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read trusted data policies, got error: %s", err))
+		return
+	}
+
+	if trustedResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("GetTrustedDataPolicies: Expected 200 OK, got status %d", trustedResp.StatusCode()),
+		)
+		return
+	}
+
+	invocationResp, err := r.client.GetToolInvocationPoliciesWithResponse(ctx)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read tool invocation policies, got error: %s", err))
+		return
+	}
+
+	if invocationResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("GetToolInvocationPolicies: Expected 200 OK, got status %d", invocationResp.StatusCode()),
+		)
+		return
+	}
+
+	trustedByProfileTool := make(map[openapi_types.UUID][]AutoconfiguredTrustedDataPolicyModel)
+	for _, policy := range *trustedResp.JSON200 {
+		trustedByProfileTool[policy.AgentToolId] = append(trustedByProfileTool[policy.AgentToolId], AutoconfiguredTrustedDataPolicyModel{
+			ID:            types.StringValue(policy.Id.String()),
+			Description:   types.StringValue(policy.Description),
+			AttributePath: types.StringValue(policy.AttributePath),
+			Operator:      types.StringValue(string(policy.Operator)),
+			Value:         types.StringValue(policy.Value),
+			Action:        types.StringValue(string(policy.Action)),
+		})
+	}
+
+	invocationByProfileTool := make(map[openapi_types.UUID][]AutoconfiguredToolInvocationPolicyModel)
+	for _, policy := range *invocationResp.JSON200 {
+		invocationByProfileTool[policy.AgentToolId] = append(invocationByProfileTool[policy.AgentToolId], AutoconfiguredToolInvocationPolicyModel{
+			ID:           types.StringValue(policy.Id.String()),
+			ArgumentName: types.StringValue(policy.ArgumentName),
+			Operator:     types.StringValue(string(policy.Operator)),
+			Value:        types.StringValue(policy.Value),
+			Action:       types.StringValue(string(policy.Action)),
+			Reason:       types.StringPointerValue(policy.Reason),
+		})
+	}
+
+	wanted := make(map[string]bool, len(toolIDs))
+	for _, toolID := range toolIDs {
+		wanted[toolID] = true
+	}
+
+	data.Tools = make([]AutoconfiguredToolModel, 0, len(toolIDs))
+	for _, assignment := range assignments {
+		if !wanted[assignment.ToolID] {
+			continue
+		}
+
+		model := AutoconfiguredToolModel{
+			ToolID:                               types.StringValue(assignment.ToolID),
+			ProfileToolID:                        types.StringValue(assignment.ID.String()),
+			ToolResultTreatment:                  types.StringValue(assignment.ToolResultTreatment),
+			AllowUsageWhenUntrustedDataIsPresent: types.BoolValue(assignment.AllowUsageWhenUntrustedDataIsPresent),
+			Reasoning:                            types.StringPointerValue(assignment.PoliciesAutoConfiguredReasoning),
+			PoliciesAutoConfiguredAt:             types.StringNull(),
+			TrustedDataPolicies:                  trustedByProfileTool[assignment.ID],
+			ToolInvocationPolicies:               invocationByProfileTool[assignment.ID],
+		}
+
+		if assignment.PoliciesAutoConfiguredAt != nil {
+			model.PoliciesAutoConfiguredAt = types.StringValue(assignment.PoliciesAutoConfiguredAt.Format(time.RFC3339))
+		}
+
+		if model.TrustedDataPolicies == nil {
+			model.TrustedDataPolicies = []AutoconfiguredTrustedDataPolicyModel{}
+		}
+
+		if model.ToolInvocationPolicies == nil {
+			model.ToolInvocationPolicies = []AutoconfiguredToolInvocationPolicyModel{}
+		}
+
+		data.Tools = append(data.Tools, model)
+	}
+
+	// Keep a stable order so refreshes do not reorder the list
+	sort.Slice(data.Tools, func(i, j int) bool {
+		return data.Tools[i].ToolID.ValueString() < data.Tools[j].ToolID.ValueString()
+	})
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Auto-configuration calls an LLM on the server, so these tests only run when
+// ARCHESTRA_TEST_POLICY_AUTOCONFIG is set against a server with an LLM API key.
+func testAccProfileToolPolicyAutoconfigPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	if os.Getenv("ARCHESTRA_TEST_POLICY_AUTOCONFIG") == "" {
+		t.Skip("ARCHESTRA_TEST_POLICY_AUTOCONFIG must be set for policy auto-configuration acceptance tests")
+	}
+}
+
+func TestAccProfileToolPolicyAutoconfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccProfileToolPolicyAutoconfigPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProfileToolPolicyAutoconfigResourceConfig("data.archestra_mcp_server_tool.read_file.id", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_profile_tool_policy_autoconfig.test", "id", "archestra_profile.test", "id"),
+					resource.TestCheckResourceAttrSet("archestra_profile_tool_policy_autoconfig.test", "prompt_template"),
+					resource.TestCheckResourceAttr("archestra_profile_tool_policy_autoconfig.test", "tools.#", "1"),
+					resource.TestCheckResourceAttrPair("archestra_profile_tool_policy_autoconfig.test", "tools.0.tool_id", "data.archestra_mcp_server_tool.read_file", "id"),
+					resource.TestCheckResourceAttrSet("archestra_profile_tool_policy_autoconfig.test", "tools.0.policies_auto_configured_at"),
+					resource.TestCheckResourceAttrSet("archestra_profile_tool_policy_autoconfig.test", "tools.0.tool_result_treatment"),
+				),
+			},
+			// Changing triggers runs auto-configuration again
+			{
+				Config: testAccProfileToolPolicyAutoconfigResourceConfig("data.archestra_mcp_server_tool.read_file.id", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_profile_tool_policy_autoconfig.test", "triggers.run", "2"),
+					resource.TestCheckResourceAttrSet("archestra_profile_tool_policy_autoconfig.test", "tools.0.policies_auto_configured_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProfileToolPolicyAutoconfigResourceToolNotAssigned(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProfileToolPolicyAutoconfigResourceConfig(`"00000000-0000-0000-0000-000000000000"`, "1"),
+				ExpectError: regexp.MustCompile(`Tool Not Assigned`),
+			},
+		},
+	})
+}
+
+func testAccProfileToolPolicyAutoconfigResourceConfig(toolID string, run string) string {
+	return fmt.Sprintf(`
+resource "archestra_profile" "test" {
+  name = "tf-acc-test-policy-autoconfig"
+}
+
+resource "archestra_mcp_registry_catalog_item" "test" {
+  name = "tf-acc-test-autoconfig-server"
+  local_config = {
+    command   = "npx"
+    arguments = ["-y", "@modelcontextprotocol/server-filesystem", "./"]
+  }
+}
+
+resource "archestra_mcp_server_installation" "test" {
+  name          = "tf-acc-test-autoconfig-server-inst"
+  mcp_server_id = archestra_mcp_registry_catalog_item.test.id
+}
+
+data "archestra_mcp_server_tool" "read_file" {
+  mcp_server_id = archestra_mcp_server_installation.test.id
+  name          = "tf-acc-test-autoconfig-server__read_file"
+  depends_on    = [archestra_mcp_server_installation.test]
+}
+
+resource "archestra_profile_tools" "test" {
+  profile_id = archestra_profile.test.id
+
+  tools = [
+    { tool_id = data.archestra_mcp_server_tool.read_file.id },
+  ]
+}
+
+resource "archestra_profile_tool_policy_autoconfig" "test" {
+  profile_id = archestra_profile.test.id
+  tool_ids   = [%[1]s]
+
+  triggers = {
+    run = %[2]q
+  }
+
+  depends_on = [archestra_profile_tools.test]
+}
+`, toolID, run)
+}
@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProfileToolSecurityBaselineResource{}
+var _ resource.ResourceWithImportState = &ProfileToolSecurityBaselineResource{}
+
+func NewProfileToolSecurityBaselineResource() resource.Resource {
+	return &ProfileToolSecurityBaselineResource{}
+}
+
+// ProfileToolSecurityBaselineResource defines the resource implementation.
+type ProfileToolSecurityBaselineResource struct {
+	client *client.ClientWithResponses
+}
+
+// ProfileToolSecurityBaselineResourceModel describes the resource data model.
+type ProfileToolSecurityBaselineResourceModel struct {
+	ID                                   types.String `tfsdk:"id"`
+	ProfileID                            types.String `tfsdk:"profile_id"`
+	ToolResultTreatment                  types.String `tfsdk:"tool_result_treatment"`
+	AllowUsageWhenUntrustedDataIsPresent types.Bool   `tfsdk:"allow_usage_when_untrusted_data_is_present"`
+	ExcludeToolIDs                       types.Set    `tfsdk:"exclude_tool_ids"`
+	ClearAutoConfigured                  types.Bool   `tfsdk:"clear_auto_configured"`
+	ToolIDs                              types.Set    `tfsdk:"tool_ids"`
+}
+
+// bulkUpdateAgentToolsBody mirrors client.BulkUpdateAgentToolsJSONRequestBody. The
+// generated union type for value has no exported constructor, so the request is
+// encoded from this struct instead.
+type bulkUpdateAgentToolsBody struct {
+	ClearAutoConfigured *bool                                    `json:"clearAutoConfigured,omitempty"`
+	Field               client.BulkUpdateAgentToolsJSONBodyField `json:"field"`
+	Ids                 []openapi_types.UUID                     `json:"ids"`
+	Value               any                                      `json:"value"`
+}
+
+func (r *ProfileToolSecurityBaselineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profile_tool_security_baseline"
+}
+
+func (r *ProfileToolSecurityBaselineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enforces baseline security settings on every tool assigned to an Archestra profile, except the tools listed in `exclude_tool_ids`. " +
+			"Tools that drift from the baseline, including tools assigned after the baseline was applied, are corrected on the next apply. " +
+			"Use `archestra_profile_tool` for the excluded tools that need different settings. " +
+			"Destroying this resource leaves the tool settings unchanged. Can be imported by profile ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the baseline (same as `profile_id`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the profile whose tools the baseline applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tool_result_treatment": schema.StringAttribute{
+				MarkdownDescription: "How to treat tool results (trusted, sanitize_with_dual_llm, untrusted)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.BulkUpdateAgentToolsJSONBodyValue1Trusted),
+						string(client.BulkUpdateAgentToolsJSONBodyValue1SanitizeWithDualLlm),
+						string(client.BulkUpdateAgentToolsJSONBodyValue1Untrusted),
+					),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("allow_usage_when_untrusted_data_is_present")),
+				},
+			},
+			"allow_usage_when_untrusted_data_is_present": schema.BoolAttribute{
+				MarkdownDescription: "Whether to allow tool usage when untrusted data is present",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.AtLeastOneOf(path.MatchRoot("tool_result_treatment")),
+				},
+			},
+			"exclude_tool_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of tools the baseline does not apply to",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"clear_auto_configured": schema.BoolAttribute{
+				MarkdownDescription: "Whether applying the baseline also clears the auto-configured policy marker on the affected tools",
+				Optional:            true,
+			},
+			"tool_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the tools the baseline currently applies to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ProfileToolSecurityBaselineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProfileToolSecurityBaselineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProfileToolSecurityBaselineResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.ProfileID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolSecurityBaselineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProfileToolSecurityBaselineResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	// Remove from state if the profile itself is gone
+	profileResp, err := r.client.GetAgentWithResponse(ctx, profileID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read profile, got error: %s", err))
+		return
+	}
+
+	if profileResp.JSON404 != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Profile ID is only null in state when the resource is being imported
+	importing := data.ProfileID.IsNull()
+
+	covered := r.coveredTools(ctx, profileID, data.ExcludeToolIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A setting is reported as null when the covered tools disagree on it, so
+	// that any drift from the configured baseline shows up as a change. Without
+	// covered tools there is nothing to compare and the baseline is kept as is.
+	if len(covered) > 0 && (!data.ToolResultTreatment.IsNull() || importing) {
+		data.ToolResultTreatment = types.StringNull()
+		if treatment, ok := commonValue(covered, func(a profileToolAssignment) string { return a.ToolResultTreatment }); ok {
+			data.ToolResultTreatment = types.StringValue(treatment)
+		}
+	}
+
+	if len(covered) > 0 && (!data.AllowUsageWhenUntrustedDataIsPresent.IsNull() || importing) {
+		data.AllowUsageWhenUntrustedDataIsPresent = types.BoolNull()
+		if allow, ok := commonValue(covered, func(a profileToolAssignment) bool { return a.AllowUsageWhenUntrustedDataIsPresent }); ok {
+			data.AllowUsageWhenUntrustedDataIsPresent = types.BoolValue(allow)
+		}
+	}
+
+	data.ProfileID = types.StringValue(profileID.String())
+	data.ToolIDs = r.toolIDs(ctx, covered, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolSecurityBaselineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProfileToolSecurityBaselineResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolSecurityBaselineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Tool settings are left as they are. Removing from Terraform state only.
+}
+
+func (r *ProfileToolSecurityBaselineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sets the baseline on every covered tool of the profile with one bulk update per setting.
+func (r *ProfileToolSecurityBaselineResource) apply(ctx context.Context, data *ProfileToolSecurityBaselineResourceModel, diags *diag.Diagnostics) {
+	profileID, err := uuid.Parse(data.ProfileID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile_id"), "Invalid Profile ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	covered := r.coveredTools(ctx, profileID, data.ExcludeToolIDs, diags)
+	if diags.HasError() {
+		return
+	}
+
+	data.ToolIDs = r.toolIDs(ctx, covered, diags)
+	if len(covered) == 0 {
+		return
+	}
+
+	ids := make([]openapi_types.UUID, len(covered))
+	for i, assignment := range covered {
+		ids[i] = assignment.ID
+	}
+
+	if !data.ToolResultTreatment.IsNull() {
+		r.bulkUpdate(ctx, bulkUpdateAgentToolsBody{
+			ClearAutoConfigured: data.ClearAutoConfigured.ValueBoolPointer(),
+			Field:               client.BulkUpdateAgentToolsJSONBodyFieldToolResultTreatment,
+			Ids:                 ids,
+			Value:               data.ToolResultTreatment.ValueString(),
+		}, diags)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if !data.AllowUsageWhenUntrustedDataIsPresent.IsNull() {
+		r.bulkUpdate(ctx, bulkUpdateAgentToolsBody{
+			ClearAutoConfigured: data.ClearAutoConfigured.ValueBoolPointer(),
+			Field:               client.BulkUpdateAgentToolsJSONBodyFieldAllowUsageWhenUntrustedDataIsPresent,
+			Ids:                 ids,
+			Value:               data.AllowUsageWhenUntrustedDataIsPresent.ValueBool(),
+		}, diags)
+	}
+}
+
+// bulkUpdate sends a single BulkUpdateAgentTools request.
+func (r *ProfileToolSecurityBaselineResource) bulkUpdate(ctx context.Context, body bulkUpdateAgentToolsBody, diags *diag.Diagnostics) {
+	buf, err := json.Marshal(body)
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode bulk update request: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.BulkUpdateAgentToolsWithBodyWithResponse(ctx, "application/json", bytes.NewReader(buf))
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to update %s on profile tools, got error: %s", body.Field, err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("BulkUpdateAgentTools: Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+	}
+}
+
+// coveredTools returns the profile's tool assignments that are not excluded from the baseline.
+func (r *ProfileToolSecurityBaselineResource) coveredTools(ctx context.Context, profileID uuid.UUID, excludeToolIDs types.Set, diags *diag.Diagnostics) []profileToolAssignment {
+	var excluded []string
+	if !excludeToolIDs.IsNull() && !excludeToolIDs.IsUnknown() {
+		diags.Append(excludeToolIDs.ElementsAs(ctx, &excluded, false)...)
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	excludedSet := make(map[string]bool, len(excluded))
+	for _, toolID := range excluded {
+		excludedSet[toolID] = true
+	}
+
+	assignments, err := listProfileTools(ctx, r.client, profileID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read profile tools, got error: %s", err))
+		return nil
+	}
+
+	covered := make([]profileToolAssignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		if !excludedSet[assignment.ToolID] {
+			covered = append(covered, assignment)
+		}
+	}
+
+	return covered
+}
+
+// toolIDs returns the tool IDs of the given assignments as a set.
+func (r *ProfileToolSecurityBaselineResource) toolIDs(ctx context.Context, assignments []profileToolAssignment, diags *diag.Diagnostics) types.Set {
+	toolIDs := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		toolIDs[i] = assignment.ToolID
+	}
+
+	result, d := types.SetValueFrom(ctx, types.StringType, toolIDs)
+	diags.Append(d...)
+
+	return result
+}
+
+// commonValue returns the value shared by every assignment, and false if they
+// disagree or there are none.
+func commonValue[T comparable](assignments []profileToolAssignment, get func(profileToolAssignment) T) (T, bool) {
+	var zero T
+	if len(assignments) == 0 {
+		return zero, false
+	}
+
+	value := get(assignments[0])
+	for _, assignment := range assignments[1:] {
+		if get(assignment) != value {
+			return zero, false
+		}
+	}
+
+	return value, true
+}
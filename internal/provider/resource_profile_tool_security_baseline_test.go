@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProfileToolSecurityBaselineResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Every tool untrusted
+			{
+				Config: testAccProfileToolSecurityBaselineResourceConfig("untrusted", `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_profile_tool_security_baseline.test", "id", "archestra_profile.test", "id"),
+					resource.TestCheckResourceAttr("archestra_profile_tool_security_baseline.test", "tool_result_treatment", "untrusted"),
+					resource.TestCheckResourceAttr("archestra_profile_tool_security_baseline.test", "tool_ids.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "archestra_profile_tool_security_baseline.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_usage_when_untrusted_data_is_present", "exclude_tool_ids"},
+			},
+			// Exclude one tool and switch the baseline
+			{
+				Config: testAccProfileToolSecurityBaselineResourceConfig("sanitize_with_dual_llm", `[data.archestra_mcp_server_tool.list_directory.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_profile_tool_security_baseline.test", "tool_result_treatment", "sanitize_with_dual_llm"),
+					resource.TestCheckResourceAttr("archestra_profile_tool_security_baseline.test", "tool_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("archestra_profile_tool_security_baseline.test", "tool_ids.*", "data.archestra_mcp_server_tool.read_file", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProfileToolSecurityBaselineResourceMissingSetting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "archestra_profile_tool_security_baseline" "test" {
+  profile_id = "00000000-0000-0000-0000-000000000000"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccProfileToolSecurityBaselineResourceConfig(treatment string, excludeToolIDs string) string {
+	return fmt.Sprintf(`
+resource "archestra_profile" "test" {
+  name = "tf-acc-test-tool-baseline"
+}
+
+resource "archestra_mcp_registry_catalog_item" "test" {
+  name = "tf-acc-test-baseline-server"
+  local_config = {
+    command   = "npx"
+    arguments = ["-y", "@modelcontextprotocol/server-filesystem", "./"]
+  }
+}
+
+resource "archestra_mcp_server_installation" "test" {
+  name          = "tf-acc-test-baseline-server-inst"
+  mcp_server_id = archestra_mcp_registry_catalog_item.test.id
+}
+
+data "archestra_mcp_server_tool" "read_file" {
+  mcp_server_id = archestra_mcp_server_installation.test.id
+  name          = "tf-acc-test-baseline-server__read_file"
+  depends_on    = [archestra_mcp_server_installation.test]
+}
+
+data "archestra_mcp_server_tool" "list_directory" {
+  mcp_server_id = archestra_mcp_server_installation.test.id
+  name          = "tf-acc-test-baseline-server__list_directory"
+  depends_on    = [archestra_mcp_server_installation.test]
+}
+
+resource "archestra_profile_tools" "test" {
+  profile_id = archestra_profile.test.id
+
+  tools = [
+    { tool_id = data.archestra_mcp_server_tool.read_file.id },
+    { tool_id = data.archestra_mcp_server_tool.list_directory.id },
+  ]
+}
+
+resource "archestra_profile_tool_security_baseline" "test" {
+  profile_id            = archestra_profile.test.id
+  tool_result_treatment = %[1]q
+  exclude_tool_ids      = %[2]s
+
+  depends_on = [archestra_profile_tools.test]
+}
+`, treatment, excludeToolIDs)
+}
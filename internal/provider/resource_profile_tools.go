@@ -0,0 +1,479 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// profileToolsPageSize is the number of profile tools requested per page when listing.
+const profileToolsPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProfileToolsResource{}
+var _ resource.ResourceWithImportState = &ProfileToolsResource{}
+
+func NewProfileToolsResource() resource.Resource {
+	return &ProfileToolsResource{}
+}
+
+// ProfileToolsResource defines the resource implementation.
+type ProfileToolsResource struct {
+	client *client.ClientWithResponses
+}
+
+// ProfileToolsAssignmentModel describes a single tool assignment.
+type ProfileToolsAssignmentModel struct {
+	ToolID                      types.String `tfsdk:"tool_id"`
+	CredentialSourceMCPServerID types.String `tfsdk:"credential_source_mcp_server_id"`
+	ExecutionSourceMCPServerID  types.String `tfsdk:"execution_source_mcp_server_id"`
+	UseDynamicTeamCredential    types.Bool   `tfsdk:"use_dynamic_team_credential"`
+}
+
+// ProfileToolsResourceModel describes the resource data model.
+type ProfileToolsResourceModel struct {
+	ID        types.String                  `tfsdk:"id"`
+	ProfileID types.String                  `tfsdk:"profile_id"`
+	Tools     []ProfileToolsAssignmentModel `tfsdk:"tools"`
+}
+
+// profileToolAssignment is a tool assigned to a profile, as reported by the API.
+type profileToolAssignment struct {
+	ID                                   openapi_types.UUID
+	ToolID                               string
+	ToolName                             string
+	CredentialSourceMCPServerID          *openapi_types.UUID
+	ExecutionSourceMCPServerID           *openapi_types.UUID
+	UseDynamicTeamCredential             bool
+	AllowUsageWhenUntrustedDataIsPresent bool
+	ToolResultTreatment                  string
+	ResponseModifierTemplate             *string
+	PoliciesAutoConfiguredAt             *time.Time
+	PoliciesAutoConfiguredReasoning      *string
+}
+
+func (r *ProfileToolsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profile_tools"
+}
+
+func (r *ProfileToolsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the full set of tools assigned to an Archestra profile. " +
+			"The `tools` set is authoritative: tools assigned to the profile outside this resource are unassigned on apply. " +
+			"Built-in Archestra tools are not managed. Do not combine with `archestra_profile_tool` for the same profile. " +
+			"Can be imported by profile ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the assignment set (same as `profile_id`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the profile to assign the tools to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tools": schema.SetNestedAttribute{
+				MarkdownDescription: "Tools assigned to the profile",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tool_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the tool to assign",
+							Required:            true,
+						},
+						"credential_source_mcp_server_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the MCP Server instance to use for credentials/authentication",
+							Optional:            true,
+						},
+						"execution_source_mcp_server_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the MCP Server instance to use for execution",
+							Optional:            true,
+						},
+						"use_dynamic_team_credential": schema.BoolAttribute{
+							MarkdownDescription: "If true, dynamically resolves credentials based on the team context at runtime (default: false)",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ProfileToolsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProfileToolsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProfileToolsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.ProfileID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProfileToolsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	// Remove from state if the profile itself is gone
+	profileResp, err := r.client.GetAgentWithResponse(ctx, profileID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read profile, got error: %s", err))
+		return
+	}
+
+	if profileResp.JSON404 != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	assignments, err := listProfileTools(ctx, r.client, profileID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read profile tools, got error: %s", err))
+		return
+	}
+
+	data.ProfileID = types.StringValue(profileID.String())
+	data.Tools = make([]ProfileToolsAssignmentModel, 0, len(assignments))
+	for _, assignment := range assignments {
+		model := ProfileToolsAssignmentModel{
+			ToolID:                      types.StringValue(assignment.ToolID),
+			CredentialSourceMCPServerID: types.StringNull(),
+			ExecutionSourceMCPServerID:  types.StringNull(),
+			UseDynamicTeamCredential:    types.BoolValue(assignment.UseDynamicTeamCredential),
+		}
+
+		if assignment.CredentialSourceMCPServerID != nil {
+			model.CredentialSourceMCPServerID = types.StringValue(assignment.CredentialSourceMCPServerID.String())
+		}
+
+		if assignment.ExecutionSourceMCPServerID != nil {
+			model.ExecutionSourceMCPServerID = types.StringValue(assignment.ExecutionSourceMCPServerID.String())
+		}
+
+		data.Tools = append(data.Tools, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProfileToolsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileToolsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProfileToolsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID, err := uuid.Parse(data.ProfileID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	for _, tool := range data.Tools {
+		r.unassign(ctx, profileID, tool.ToolID.ValueString(), &resp.Diagnostics)
+	}
+}
+
+func (r *ProfileToolsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// reconcile makes the profile's tool assignments match data.Tools. New tools are
+// assigned in a single bulk call, changed ones are updated in place and tools no
+// longer listed are unassigned.
+func (r *ProfileToolsResource) reconcile(ctx context.Context, data *ProfileToolsResourceModel, diags *diag.Diagnostics) {
+	profileID, err := uuid.Parse(data.ProfileID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile_id"), "Invalid Profile ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	current, err := listProfileTools(ctx, r.client, profileID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read profile tools, got error: %s", err))
+		return
+	}
+
+	currentByTool := make(map[string]profileToolAssignment, len(current))
+	for _, assignment := range current {
+		currentByTool[assignment.ToolID] = assignment
+	}
+
+	body := client.BulkAssignToolsJSONRequestBody{}
+	desired := make(map[string]bool, len(data.Tools))
+
+	for _, tool := range data.Tools {
+		toolID, err := uuid.Parse(tool.ToolID.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("tools"), "Invalid Tool ID", fmt.Sprintf("Unable to parse tool ID %q: %s", tool.ToolID.ValueString(), err))
+			return
+		}
+		desired[toolID.String()] = true
+
+		credentialSourceID, ok := parseOptionalUUID(tool.CredentialSourceMCPServerID, diags)
+		if !ok {
+			return
+		}
+
+		executionSourceID, ok := parseOptionalUUID(tool.ExecutionSourceMCPServerID, diags)
+		if !ok {
+			return
+		}
+
+		useDynamicTeamCredential := tool.UseDynamicTeamCredential.ValueBool()
+
+		existing, assigned := currentByTool[toolID.String()]
+		if !assigned {
+			body.Assignments = append(body.Assignments, struct {
+				AgentId                     openapi_types.UUID  `json:"agentId"`
+				CredentialSourceMcpServerId *openapi_types.UUID `json:"credentialSourceMcpServerId"`
+				ExecutionSourceMcpServerId  *openapi_types.UUID `json:"executionSourceMcpServerId"`
+				ToolId                      openapi_types.UUID  `json:"toolId"`
+				UseDynamicTeamCredential    *bool               `json:"useDynamicTeamCredential,omitempty"`
+			}{
+				AgentId:                     profileID,
+				CredentialSourceMcpServerId: credentialSourceID,
+				ExecutionSourceMcpServerId:  executionSourceID,
+				ToolId:                      toolID,
+				UseDynamicTeamCredential:    &useDynamicTeamCredential,
+			})
+			continue
+		}
+
+		if uuidPtrEqual(existing.CredentialSourceMCPServerID, credentialSourceID) &&
+			uuidPtrEqual(existing.ExecutionSourceMCPServerID, executionSourceID) &&
+			existing.UseDynamicTeamCredential == useDynamicTeamCredential {
+			continue
+		}
+
+		// Nullable fields are sent as-is, so carry over the ones this resource
+		// does not manage to avoid clearing them.
+		updateBody := client.UpdateAgentToolJSONRequestBody{
+			CredentialSourceMcpServerId: credentialSourceID,
+			ExecutionSourceMcpServerId:  executionSourceID,
+			UseDynamicTeamCredential:    &useDynamicTeamCredential,
+			ResponseModifierTemplate:    existing.ResponseModifierTemplate,
+		}
+		if existing.PoliciesAutoConfiguredAt != nil {
+			updateBody.PoliciesAutoConfiguredAt = existing.PoliciesAutoConfiguredAt
+		}
+
+		updateResp, err := r.client.UpdateAgentToolWithResponse(ctx, existing.ID, updateBody)
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Unable to update profile tool %s, got error: %s", toolID, err))
+			return
+		}
+
+		if updateResp.JSON200 == nil {
+			diags.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("UpdateAgentTool: Expected 200 OK, got status %d", updateResp.StatusCode()),
+			)
+			return
+		}
+	}
+
+	if len(body.Assignments) > 0 {
+		assignResp, err := r.client.BulkAssignToolsWithResponse(ctx, body)
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Unable to assign tools to profile, got error: %s", err))
+			return
+		}
+
+		if assignResp.JSON200 == nil {
+			diags.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("BulkAssignTools: Expected 200 OK, got status %d", assignResp.StatusCode()),
+			)
+			return
+		}
+
+		if len(assignResp.JSON200.Failed) > 0 {
+			failures := make([]string, 0, len(assignResp.JSON200.Failed))
+			for _, failed := range assignResp.JSON200.Failed {
+				failures = append(failures, fmt.Sprintf("%s: %s", failed.ToolId, failed.Error))
+			}
+			diags.AddAttributeError(
+				path.Root("tools"),
+				"Tool Assignment Failed",
+				fmt.Sprintf("Unable to assign %d tool(s) to profile %s:\n%s", len(failures), profileID, strings.Join(failures, "\n")),
+			)
+			return
+		}
+	}
+
+	for _, assignment := range current {
+		if !desired[assignment.ToolID] {
+			r.unassign(ctx, profileID, assignment.ToolID, diags)
+		}
+	}
+}
+
+// unassign removes a single tool from the profile, treating an already missing assignment as success.
+func (r *ProfileToolsResource) unassign(ctx context.Context, profileID uuid.UUID, toolIDStr string, diags *diag.Diagnostics) {
+	toolID, err := uuid.Parse(toolIDStr)
+	if err != nil {
+		diags.AddError("Invalid Tool ID", fmt.Sprintf("Unable to parse tool ID %q: %s", toolIDStr, err))
+		return
+	}
+
+	delResp, err := r.client.UnassignToolFromAgentWithResponse(ctx, profileID, toolID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to unassign tool %s, got error: %s", toolID, err))
+		return
+	}
+
+	if delResp.StatusCode() != 200 && delResp.StatusCode() != 404 {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK or 404 Not Found when unassigning tool %s, got status %d", toolID, delResp.StatusCode()),
+		)
+	}
+}
+
+// listProfileTools pages through every tool assigned to a profile, excluding built-in Archestra tools.
+func listProfileTools(ctx context.Context, c *client.ClientWithResponses, profileID uuid.UUID) ([]profileToolAssignment, error) {
+	limit := profileToolsPageSize
+	excludeArchestraTools := true
+	params := &client.GetAllAgentToolsParams{
+		AgentId:               &profileID,
+		ExcludeArchestraTools: &excludeArchestraTools,
+		Limit:                 &limit,
+	}
+
+	var assignments []profileToolAssignment
+	for offset := 0; ; {
+		params.Offset = &offset
+
+		resp, err := c.GetAllAgentToolsWithResponse(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("listing tools failed with status %d", resp.StatusCode())
+		}
+
+		for _, at := range resp.JSON200.Data {
+			assignments = append(assignments, profileToolAssignment{
+				ID:                                   at.Id,
+				ToolID:                               at.Tool.Id,
+				ToolName:                             at.Tool.Name,
+				CredentialSourceMCPServerID:          at.CredentialSourceMcpServerId,
+				ExecutionSourceMCPServerID:           at.ExecutionSourceMcpServerId,
+				UseDynamicTeamCredential:             at.UseDynamicTeamCredential,
+				AllowUsageWhenUntrustedDataIsPresent: at.AllowUsageWhenUntrustedDataIsPresent,
+				ToolResultTreatment:                  string(at.ToolResultTreatment),
+				ResponseModifierTemplate:             at.ResponseModifierTemplate,
+				PoliciesAutoConfiguredAt:             at.PoliciesAutoConfiguredAt,
+				PoliciesAutoConfiguredReasoning:      at.PoliciesAutoConfiguredReasoning,
+			})
+		}
+
+		if !resp.JSON200.Pagination.HasNext || len(resp.JSON200.Data) == 0 {
+			break
+		}
+		offset += len(resp.JSON200.Data)
+	}
+
+	return assignments, nil
+}
+
+// parseOptionalUUID parses an optional UUID attribute, returning nil when it is null or unknown.
+func parseOptionalUUID(value types.String, diags *diag.Diagnostics) (*openapi_types.UUID, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, true
+	}
+
+	id, err := uuid.Parse(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("tools"), "Invalid MCP Server ID", fmt.Sprintf("Unable to parse MCP server ID %q: %s", value.ValueString(), err))
+		return nil, false
+	}
+
+	return &id, true
+}
+
+// uuidPtrEqual reports whether two optional UUIDs are both nil or hold the same value.
+func uuidPtrEqual(a, b *openapi_types.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
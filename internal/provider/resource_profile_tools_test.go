@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProfileToolsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a single tool
+			{
+				Config: testAccProfileToolsResourceConfig(`
+    {
+      tool_id = data.archestra_mcp_server_tool.read_file.id
+    },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_profile_tools.test", "id", "archestra_profile.test", "id"),
+					resource.TestCheckResourceAttr("archestra_profile_tools.test", "tools.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("archestra_profile_tools.test", "tools.*", map[string]string{
+						"use_dynamic_team_credential": "false",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_profile_tools.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Add a second tool and change the first in place
+			{
+				Config: testAccProfileToolsResourceConfig(`
+    {
+      tool_id                         = data.archestra_mcp_server_tool.read_file.id
+      credential_source_mcp_server_id = archestra_mcp_server_installation.test.id
+      execution_source_mcp_server_id  = archestra_mcp_server_installation.test.id
+    },
+    {
+      tool_id                     = data.archestra_mcp_server_tool.list_directory.id
+      use_dynamic_team_credential = true
+    },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_profile_tools.test", "tools.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("archestra_profile_tools.test", "tools.*.credential_source_mcp_server_id", "archestra_mcp_server_installation.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("archestra_profile_tools.test", "tools.*", map[string]string{
+						"use_dynamic_team_credential": "true",
+					}),
+				),
+			},
+			// Drop the first tool
+			{
+				Config: testAccProfileToolsResourceConfig(`
+    {
+      tool_id                     = data.archestra_mcp_server_tool.list_directory.id
+      use_dynamic_team_credential = true
+    },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_profile_tools.test", "tools.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("archestra_profile_tools.test", "tools.*.tool_id", "data.archestra_mcp_server_tool.list_directory", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProfileToolsResourceConfig(tools string) string {
+	return fmt.Sprintf(`
+resource "archestra_profile" "test" {
+  name = "tf-acc-test-profile-tools"
+}
+
+resource "archestra_mcp_registry_catalog_item" "test" {
+  name = "tf-acc-test-tools-server"
+  local_config = {
+    command   = "npx"
+    arguments = ["-y", "@modelcontextprotocol/server-filesystem", "./"]
+  }
+}
+
+resource "archestra_mcp_server_installation" "test" {
+  name          = "tf-acc-test-tools-server-inst"
+  mcp_server_id = archestra_mcp_registry_catalog_item.test.id
+}
+
+data "archestra_mcp_server_tool" "read_file" {
+  mcp_server_id = archestra_mcp_server_installation.test.id
+  name          = "tf-acc-test-tools-server__read_file"
+  depends_on    = [archestra_mcp_server_installation.test]
+}
+
+data "archestra_mcp_server_tool" "list_directory" {
+  mcp_server_id = archestra_mcp_server_installation.test.id
+  name          = "tf-acc-test-tools-server__list_directory"
+  depends_on    = [archestra_mcp_server_installation.test]
+}
+
+resource "archestra_profile_tools" "test" {
+  profile_id = archestra_profile.test.id
+
+  tools = [
+%[1]s  ]
+}
+`, tools)
+}
@@ -0,0 +1,492 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PromptResource{}
+var _ resource.ResourceWithImportState = &PromptResource{}
+var _ resource.ResourceWithModifyPlan = &PromptResource{}
+
+func NewPromptResource() resource.Resource {
+	return &PromptResource{}
+}
+
+// PromptResource defines the resource implementation.
+type PromptResource struct {
+	client *client.ClientWithResponses
+}
+
+// PromptResourceModel describes the resource data model.
+type PromptResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ProfileID      types.String `tfsdk:"profile_id"`
+	Name           types.String `tfsdk:"name"`
+	SystemPrompt   types.String `tfsdk:"system_prompt"`
+	UserPrompt     types.String `tfsdk:"user_prompt"`
+	Version        types.Int64  `tfsdk:"version"`
+	IsActive       types.Bool   `tfsdk:"is_active"`
+	ParentPromptID types.String `tfsdk:"parent_prompt_id"`
+	PinnedVersion  types.Int64  `tfsdk:"pinned_version"`
+}
+
+// promptAPIModel is the prompt shape shared by all prompt endpoints of the generated client.
+type promptAPIModel = struct {
+	AgentId        openapi_types.UUID  `json:"agentId"`
+	CreatedAt      time.Time           `json:"createdAt"`
+	Id             openapi_types.UUID  `json:"id"`
+	IsActive       bool                `json:"isActive"`
+	Name           string              `json:"name"`
+	OrganizationId string              `json:"organizationId"`
+	ParentPromptId *openapi_types.UUID `json:"parentPromptId"`
+	SystemPrompt   *string             `json:"systemPrompt"`
+	UpdatedAt      time.Time           `json:"updatedAt"`
+	UserPrompt     *string             `json:"userPrompt"`
+	Version        int                 `json:"version"`
+}
+
+func (r *PromptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt"
+}
+
+func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Archestra prompt attached to a profile. " +
+			"Every change to the prompt creates a new version on the server; `id` always refers to the currently active version. " +
+			"Set `pinned_version` to roll the prompt back to an earlier version without discarding the newer ones.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the currently active prompt version",
+			},
+			"profile_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the profile this prompt belongs to",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the prompt",
+				Required:            true,
+			},
+			"system_prompt": schema.StringAttribute{
+				MarkdownDescription: "The system prompt text",
+				Optional:            true,
+			},
+			"user_prompt": schema.StringAttribute{
+				MarkdownDescription: "The user prompt text",
+				Optional:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Version number of the currently active prompt version",
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the prompt version referenced by `id` is active",
+				Computed:            true,
+			},
+			"parent_prompt_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the prompt this version was derived from",
+				Computed:            true,
+			},
+			"pinned_version": schema.Int64Attribute{
+				MarkdownDescription: "Version number to keep active. When set, the prompt is rolled back to this version and " +
+					"`system_prompt`/`user_prompt` describe the latest authored version rather than the active one. " +
+					"Removing the attribute re-activates the latest version. A new prompt can only be pinned to version 1. " +
+					"Available versions can be listed with the `archestra_prompt_versions` data source.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects pinning a new prompt to any version but the first, as
+// no other version exists until the prompt has been updated.
+func (r *PromptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var pinnedVersion types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("pinned_version"), &pinnedVersion)...)
+	if resp.Diagnostics.HasError() || pinnedVersion.IsNull() || pinnedVersion.IsUnknown() {
+		return
+	}
+
+	if pinnedVersion.ValueInt64() != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pinned_version"),
+			"Prompt Version Not Found",
+			fmt.Sprintf("A new prompt only has version 1, so it cannot be pinned to version %d. "+
+				"Create the prompt first, then pin it once that version exists.", pinnedVersion.ValueInt64()),
+		)
+	}
+}
+
+func (r *PromptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PromptResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID, err := uuid.Parse(data.ProfileID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile_id"), "Invalid Profile ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	isActive := true
+	requestBody := client.CreatePromptJSONRequestBody{
+		AgentId:      profileID,
+		Name:         data.Name.ValueString(),
+		IsActive:     &isActive,
+		SystemPrompt: data.SystemPrompt.ValueStringPointer(),
+		UserPrompt:   data.UserPrompt.ValueStringPointer(),
+	}
+
+	apiResp, err := r.client.CreatePromptWithResponse(ctx, requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to create prompt, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	prompt := apiResp.JSON200
+
+	if !data.PinnedVersion.IsNull() && int64(prompt.Version) != data.PinnedVersion.ValueInt64() {
+		prompt = r.rollbackToVersion(ctx, prompt.Id, data.PinnedVersion.ValueInt64(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.mapResponseToModel(prompt, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PromptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PromptResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promptID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse prompt ID: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.GetPromptWithResponse(ctx, promptID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read prompt, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON404 != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	prompt := apiResp.JSON200
+
+	// The version in state may have been superseded outside of Terraform
+	// (e.g. edited in the UI). Follow the version history to the active one
+	// so the drift is reported against the prompt that is actually in use.
+	if !prompt.IsActive {
+		versions := r.getVersions(ctx, promptID, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for i := range versions {
+			if versions[i].IsActive {
+				prompt = &versions[i]
+				break
+			}
+		}
+	}
+
+	r.mapResponseToModel(prompt, &data)
+
+	// A pinned prompt reports the active version number as its pin, so that
+	// activating another version outside of Terraform shows up as a diff.
+	if !data.PinnedVersion.IsNull() {
+		data.PinnedVersion = types.Int64Value(int64(prompt.Version))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PromptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PromptResourceModel
+	var state PromptResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promptID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse prompt ID: %s", err))
+		return
+	}
+
+	profileID, err := uuid.Parse(data.ProfileID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile_id"), "Invalid Profile ID", fmt.Sprintf("Unable to parse profile ID: %s", err))
+		return
+	}
+
+	var prompt *promptAPIModel
+
+	// Only author a new version when the prompt itself changed; a pin change
+	// alone must not add entries to the version history.
+	if !data.ProfileID.Equal(state.ProfileID) || !data.Name.Equal(state.Name) ||
+		!data.SystemPrompt.Equal(state.SystemPrompt) || !data.UserPrompt.Equal(state.UserPrompt) {
+		name := data.Name.ValueString()
+		requestBody := client.UpdatePromptJSONRequestBody{
+			AgentId:      &profileID,
+			Name:         &name,
+			SystemPrompt: data.SystemPrompt.ValueStringPointer(),
+			UserPrompt:   data.UserPrompt.ValueStringPointer(),
+		}
+
+		apiResp, err := r.client.UpdatePromptWithResponse(ctx, promptID, requestBody)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to update prompt, got error: %s", err))
+			return
+		}
+
+		if apiResp.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+			)
+			return
+		}
+
+		prompt = apiResp.JSON200
+		promptID = prompt.Id
+	}
+
+	switch {
+	case !data.PinnedVersion.IsNull():
+		prompt = r.rollbackToVersion(ctx, promptID, data.PinnedVersion.ValueInt64(), &resp.Diagnostics)
+	case !state.PinnedVersion.IsNull():
+		// The pin was removed: re-activate the most recent version.
+		prompt = r.rollbackToVersion(ctx, promptID, 0, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if prompt == nil {
+		apiResp, err := r.client.GetPromptWithResponse(ctx, promptID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read prompt after update, got error: %s", err))
+			return
+		}
+
+		if apiResp.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("Expected 200 OK on read after update, got status %d", apiResp.StatusCode()),
+			)
+			return
+		}
+
+		prompt = apiResp.JSON200
+	}
+
+	r.mapResponseToModel(prompt, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PromptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PromptResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promptID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse prompt ID: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.DeletePromptWithResponse(ctx, promptID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to delete prompt, got error: %s", err))
+		return
+	}
+
+	// Check response (200 or 404 are both acceptable for delete)
+	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+}
+
+func (r *PromptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getVersions returns the full version history of the prompt.
+func (r *PromptResource) getVersions(ctx context.Context, promptID uuid.UUID, diags *diag.Diagnostics) []promptAPIModel {
+	apiResp, err := r.client.GetPromptVersionsWithResponse(ctx, promptID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read prompt versions, got error: %s", err))
+		return nil
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK for prompt versions, got status %d", apiResp.StatusCode()),
+		)
+		return nil
+	}
+
+	return *apiResp.JSON200
+}
+
+// rollbackToVersion activates the given version number of the prompt. A version
+// of 0 selects the most recent version.
+func (r *PromptResource) rollbackToVersion(ctx context.Context, promptID uuid.UUID, version int64, diags *diag.Diagnostics) *promptAPIModel {
+	versions := r.getVersions(ctx, promptID, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	var target *promptAPIModel
+	for i := range versions {
+		if version == 0 {
+			if target == nil || versions[i].Version > target.Version {
+				target = &versions[i]
+			}
+		} else if int64(versions[i].Version) == version {
+			target = &versions[i]
+			break
+		}
+	}
+
+	if target == nil {
+		diags.AddAttributeError(
+			path.Root("pinned_version"),
+			"Prompt Version Not Found",
+			fmt.Sprintf("Prompt %s has no version %d", promptID, version),
+		)
+		return nil
+	}
+
+	if target.IsActive {
+		return target
+	}
+
+	apiResp, err := r.client.RollbackPromptWithResponse(ctx, promptID, client.RollbackPromptJSONRequestBody{
+		VersionId: target.Id,
+	})
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to roll back prompt, got error: %s", err))
+		return nil
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK on rollback, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return nil
+	}
+
+	return apiResp.JSON200
+}
+
+// mapResponseToModel copies a prompt returned by the API into the Terraform model.
+// While a version is pinned, the prompt content in the model is left untouched:
+// it tracks the latest authored version, not the (older) active one.
+func (r *PromptResource) mapResponseToModel(prompt *promptAPIModel, data *PromptResourceModel) {
+	data.ID = types.StringValue(prompt.Id.String())
+	data.ProfileID = types.StringValue(prompt.AgentId.String())
+	if data.PinnedVersion.IsNull() {
+		data.Name = types.StringValue(prompt.Name)
+		data.SystemPrompt = types.StringPointerValue(prompt.SystemPrompt)
+		data.UserPrompt = types.StringPointerValue(prompt.UserPrompt)
+	}
+	data.Version = types.Int64Value(int64(prompt.Version))
+	data.IsActive = types.BoolValue(prompt.IsActive)
+
+	if prompt.ParentPromptId != nil {
+		data.ParentPromptID = types.StringValue(prompt.ParentPromptId.String())
+	} else {
+		data.ParentPromptID = types.StringNull()
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccPromptResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPromptResourceConfig("You are a helpful assistant."),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("tf-acc-test-prompt"),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("system_prompt"),
+						knownvalue.StringExact("You are a helpful assistant."),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("version"),
+						knownvalue.Int64Exact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("is_active"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_prompt.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccPromptResourceConfig("You are a concise assistant."),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("system_prompt"),
+						knownvalue.StringExact("You are a concise assistant."),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("version"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("is_active"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Roll back to the first version
+			{
+				Config: testAccPromptResourceConfigPinned("You are a concise assistant.", 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("version"),
+						knownvalue.Int64Exact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("pinned_version"),
+						knownvalue.Int64Exact(1),
+					),
+				},
+			},
+			// Unpin to re-activate the latest version
+			{
+				Config: testAccPromptResourceConfig("You are a concise assistant."),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("version"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_prompt.test",
+						tfjsonpath.New("pinned_version"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPromptResource_PinnedVersionOnCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPromptResourceConfigPinned("You are a helpful assistant.", 2),
+				ExpectError: regexp.MustCompile(`A new prompt only has version 1`),
+			},
+		},
+	})
+}
+
+func testAccPromptResourceConfigPinned(systemPrompt string, pinnedVersion int) string {
+	return fmt.Sprintf(`
+resource "archestra_profile" "test" {
+  name = "tf-acc-test-prompt-profile"
+}
+
+resource "archestra_prompt" "test" {
+  profile_id     = archestra_profile.test.id
+  name           = "tf-acc-test-prompt"
+  system_prompt  = %[1]q
+  user_prompt    = "Summarize the conversation."
+  pinned_version = %[2]d
+}
+`, systemPrompt, pinnedVersion)
+}
+
+func testAccPromptResourceConfig(systemPrompt string) string {
+	return fmt.Sprintf(`
+resource "archestra_profile" "test" {
+  name = "tf-acc-test-prompt-profile"
+}
+
+resource "archestra_prompt" "test" {
+  profile_id    = archestra_profile.test.id
+  name          = "tf-acc-test-prompt"
+  system_prompt = %[1]q
+  user_prompt   = "Summarize the conversation."
+}
+`, systemPrompt)
+}
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleResource{}
+var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithModifyPlan = &RoleResource{}
+
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+// RoleResource defines the resource implementation.
+type RoleResource struct {
+	client *client.ClientWithResponses
+}
+
+// RoleResourceModel describes the resource data model.
+type RoleResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Role       types.String `tfsdk:"role"`
+	Permission types.Map    `tfsdk:"permission"`
+}
+
+// rolePermissionActions lists the actions that can be granted on a resource in a custom role.
+var rolePermissionActions = []string{
+	string(client.CreateRoleJSONBodyPermissionCreate),
+	string(client.CreateRoleJSONBodyPermissionRead),
+	string(client.CreateRoleJSONBodyPermissionUpdate),
+	string(client.CreateRoleJSONBodyPermissionDelete),
+	string(client.CreateRoleJSONBodyPermissionAdmin),
+	string(client.CreateRoleJSONBodyPermissionCancel),
+}
+
+func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom RBAC role in Archestra.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Role identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role string to reference when assigning this role, e.g. in `archestra_team` members",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permission": schema.MapAttribute{
+				MarkdownDescription: "Map of resource name to the set of actions granted on it. " +
+					"Valid actions are `create`, `read`, `update`, `delete`, `admin` and `cancel`.",
+				Required:    true,
+				ElementType: types.SetType{ElemType: types.StringType},
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(
+						setvalidator.ValueStringsAre(
+							stringvalidator.OneOf(rolePermissionActions...),
+						),
+					),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan marks the role string as unknown when the name changes, as the
+// server may derive it from the name.
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role"), types.StringUnknown())...)
+	}
+}
+
+func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var permission map[string][]string
+	resp.Diagnostics.Append(data.Permission.ElementsAs(ctx, &permission, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestBody := client.CreateRoleJSONRequestBody{
+		Name:       data.Name.ValueString(),
+		Permission: make(map[string][]client.CreateRoleJSONBodyPermission, len(permission)),
+	}
+	for resourceName, actions := range permission {
+		requestBody.Permission[resourceName] = make([]client.CreateRoleJSONBodyPermission, len(actions))
+		for i, action := range actions {
+			requestBody.Permission[resourceName][i] = client.CreateRoleJSONBodyPermission(action)
+		}
+	}
+
+	apiResp, err := r.client.CreateRoleWithResponse(ctx, requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to create role, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(apiResp.JSON200.Id)
+	data.Name = types.StringValue(apiResp.JSON200.Name)
+	data.Role = types.StringValue(apiResp.JSON200.Role)
+	data.Permission = rolePermissionToMap(ctx, apiResp.JSON200.Permission, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The single-role endpoint takes a union-typed path parameter the generated
+	// client cannot build, so look the role up in the organization's role list.
+	apiResp, err := r.client.GetRolesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read roles, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	for _, role := range *apiResp.JSON200 {
+		if role.Id != data.ID.ValueString() {
+			continue
+		}
+
+		data.Name = types.StringValue(role.Name)
+		data.Role = types.StringValue(role.Role)
+		data.Permission = rolePermissionToMap(ctx, role.Permission, &resp.Diagnostics)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var permission map[string][]string
+	resp.Diagnostics.Append(data.Permission.ElementsAs(ctx, &permission, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	requestPermission := make(map[string][]client.UpdateRoleJSONBodyPermission, len(permission))
+	for resourceName, actions := range permission {
+		requestPermission[resourceName] = make([]client.UpdateRoleJSONBodyPermission, len(actions))
+		for i, action := range actions {
+			requestPermission[resourceName][i] = client.UpdateRoleJSONBodyPermission(action)
+		}
+	}
+
+	requestBody := client.UpdateRoleJSONRequestBody{
+		Name:       &name,
+		Permission: &requestPermission,
+	}
+
+	apiResp, err := r.updateRole(ctx, data.ID.ValueString(), requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to update role, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.Name = types.StringValue(apiResp.JSON200.Name)
+	data.Role = types.StringValue(apiResp.JSON200.Role)
+	data.Permission = rolePermissionToMap(ctx, apiResp.JSON200.Permission, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.DeleteRoleWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to delete role, got error: %s", err))
+		return
+	}
+
+	// Check response (200 or 404 are both acceptable for delete)
+	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateRole sends an UpdateRole request. The generated UpdateRoleWithResponse
+// takes its roleId path parameter as an anonymous union type that cannot be
+// constructed outside the client package, so the request is assembled here and
+// decoded with the generated response parser.
+func (r *RoleResource) updateRole(ctx context.Context, roleID string, body client.UpdateRoleJSONRequestBody) (*client.UpdateRoleResponse, error) {
+	c, ok := r.client.ClientInterface.(*client.Client)
+	if !ok {
+		return nil, fmt.Errorf("unexpected client implementation %T", r.client.ClientInterface)
+	}
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	queryURL, err := serverURL.Parse("./api/roles/" + url.PathEscape(roleID))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, queryURL.String(), bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	for _, editor := range c.RequestEditors {
+		if err := editor(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ParseUpdateRoleResponse(rsp)
+}
+
+// rolePermissionToMap converts a permission map returned by the API into a Terraform map of string sets.
+func rolePermissionToMap[T ~string](ctx context.Context, permission map[string][]T, diags *diag.Diagnostics) types.Map {
+	elements := make(map[string][]string, len(permission))
+	for resourceName, actions := range permission {
+		elements[resourceName] = make([]string, len(actions))
+		for i, action := range actions {
+			elements[resourceName][i] = string(action)
+		}
+	}
+
+	result, d := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, elements)
+	diags.Append(d...)
+
+	return result
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleResourceConfig("tf-acc-test-role", `["read"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_role.test", "name", "tf-acc-test-role"),
+					resource.TestCheckResourceAttr("archestra_role.test", "permission.agent.#", "1"),
+					resource.TestCheckTypeSetElemAttr("archestra_role.test", "permission.agent.*", "read"),
+					resource.TestCheckResourceAttrSet("archestra_role.test", "id"),
+					resource.TestCheckResourceAttrSet("archestra_role.test", "role"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRoleResourceConfig("tf-acc-test-role", `["read", "update"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_role.test", "permission.agent.#", "2"),
+					resource.TestCheckTypeSetElemAttr("archestra_role.test", "permission.agent.*", "update"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRoleResourceInvalidAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleResourceConfig("tf-acc-test-role-invalid", `["read", "write"]`),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccRoleResourceConfig(name string, agentActions string) string {
+	return fmt.Sprintf(`
+resource "archestra_role" "test" {
+  name = %[1]q
+
+  permission = {
+    agent = %[2]s
+    tool  = ["read"]
+  }
+}
+`, name, agentActions)
+}
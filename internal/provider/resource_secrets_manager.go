@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecretsManagerResource{}
+var _ resource.ResourceWithImportState = &SecretsManagerResource{}
+
+// secretsManagerID is the fixed identifier of the singleton secrets manager resource.
+const secretsManagerID = "secrets_manager"
+
+func NewSecretsManagerResource() resource.Resource {
+	return &SecretsManagerResource{}
+}
+
+// SecretsManagerResource defines the resource implementation.
+type SecretsManagerResource struct {
+	client *client.ClientWithResponses
+}
+
+// SecretsManagerResourceModel describes the resource data model.
+type SecretsManagerResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+	Meta types.Map    `tfsdk:"meta"`
+}
+
+func (r *SecretsManagerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_manager"
+}
+
+func (r *SecretsManagerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Selects the secrets backend used by Archestra. This is a singleton resource - only one instance can exist per deployment. " +
+			"After a Vault backend is initialized its connectivity is checked, and the apply fails if it cannot be reached. " +
+			"Note: Running `terraform destroy` will only remove this resource from Terraform state; the secrets backend will remain unchanged on the server.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Secrets manager identifier (always `secrets_manager`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Secrets backend type: `DB`, `Vault` or `BYOS_VAULT`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.DB),
+						string(client.Vault),
+						string(client.BYOSVAULT),
+					),
+				},
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Backend-specific metadata reported by the server",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *SecretsManagerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SecretsManagerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecretsManagerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.initialize(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The backend has been switched at this point, so record it in state even
+	// if it turns out to be unreachable.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkConnectivity(ctx, &data, &resp.Diagnostics)
+}
+
+func (r *SecretsManagerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecretsManagerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetSecretsTypeWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read secrets manager type, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(secretsManagerID)
+	data.Type = types.StringValue(string(apiResp.JSON200.Type))
+
+	meta, diags := types.MapValueFrom(ctx, types.StringType, apiResp.JSON200.Meta)
+	resp.Diagnostics.Append(diags...)
+	data.Meta = meta
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretsManagerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SecretsManagerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.initialize(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The backend has been switched at this point, so record it in state even
+	// if it turns out to be unreachable.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkConnectivity(ctx, &data, &resp.Diagnostics)
+}
+
+func (r *SecretsManagerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The secrets backend cannot be reset via API.
+	// Removing from Terraform state only - the backend selection will remain on the server.
+}
+
+func (r *SecretsManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// initialize selects the secrets backend.
+func (r *SecretsManagerResource) initialize(ctx context.Context, data *SecretsManagerResourceModel, diags *diag.Diagnostics) {
+	apiResp, err := r.client.InitializeSecretsManagerWithResponse(ctx, client.InitializeSecretsManagerJSONRequestBody{
+		Type: client.InitializeSecretsManagerJSONBodyType(data.Type.ValueString()),
+	})
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to initialize secrets manager, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(secretsManagerID)
+	data.Type = types.StringValue(string(apiResp.JSON200.Type))
+
+	meta, d := types.MapValueFrom(ctx, types.StringType, apiResp.JSON200.Meta)
+	diags.Append(d...)
+	data.Meta = meta
+}
+
+// checkConnectivity verifies that the active secrets backend is reachable. The
+// API only checks the active backend, so this runs after initialize.
+func (r *SecretsManagerResource) checkConnectivity(ctx context.Context, data *SecretsManagerResourceModel, diags *diag.Diagnostics) {
+	// The database backend lives in Archestra's own database, so there is no
+	// external system to verify.
+	if data.Type.ValueString() == string(client.DB) {
+		return
+	}
+
+	checkResp, err := r.client.CheckSecretsConnectivityWithResponse(ctx)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to check secrets manager connectivity, got error: %s", err))
+		return
+	}
+
+	if checkResp.JSON200 == nil {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Secrets Backend Unreachable",
+			fmt.Sprintf("The %s secrets backend was selected but its connectivity check failed with status %d: %s",
+				data.Type.ValueString(), checkResp.StatusCode(), secretsConnectivityErrorMessage(checkResp)),
+		)
+	}
+}
+
+// secretsConnectivityErrorMessage extracts the server's error message from a failed connectivity check.
+func secretsConnectivityErrorMessage(resp *client.CheckSecretsConnectivityResponse) string {
+	switch {
+	case resp.JSON400 != nil:
+		return resp.JSON400.Error.Message
+	case resp.JSON401 != nil:
+		return resp.JSON401.Error.Message
+	case resp.JSON403 != nil:
+		return resp.JSON403.Error.Message
+	case resp.JSON404 != nil:
+		return resp.JSON404.Error.Message
+	case resp.JSON409 != nil:
+		return resp.JSON409.Error.Message
+	case resp.JSON500 != nil:
+		return resp.JSON500.Error.Message
+	}
+	return string(resp.Body)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSecretsManagerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSecretsManagerResourceConfig("DB"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_secrets_manager.test", "id", "secrets_manager"),
+					resource.TestCheckResourceAttr("archestra_secrets_manager.test", "type", "DB"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_secrets_manager.test",
+				ImportState:       true,
+				ImportStateId:     "secrets_manager",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerResourceInvalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSecretsManagerResourceConfig("AWS"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccSecretsManagerResourceConfig(backendType string) string {
+	return `
+resource "archestra_secrets_manager" "test" {
+  type = "` + backendType + `"
+}
+`
+}
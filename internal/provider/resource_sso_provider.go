@@ -0,0 +1,935 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SSOProviderResource{}
+var _ resource.ResourceWithImportState = &SSOProviderResource{}
+
+func NewSSOProviderResource() resource.Resource {
+	return &SSOProviderResource{}
+}
+
+// SSOProviderResource defines the resource implementation.
+type SSOProviderResource struct {
+	client *client.ClientWithResponses
+}
+
+// SSOProviderResourceModel describes the resource data model.
+type SSOProviderResourceModel struct {
+	ID             types.String         `tfsdk:"id"`
+	ProviderID     types.String         `tfsdk:"provider_id"`
+	Domain         types.String         `tfsdk:"domain"`
+	Issuer         types.String         `tfsdk:"issuer"`
+	DomainVerified types.Bool           `tfsdk:"domain_verified"`
+	OIDCConfig     *SSOOIDCConfigModel  `tfsdk:"oidc_config"`
+	SAMLConfig     *SSOSAMLConfigModel  `tfsdk:"saml_config"`
+	RoleMapping    *SSORoleMappingModel `tfsdk:"role_mapping"`
+}
+
+// SSOOIDCConfigModel describes the OIDC configuration of an SSO provider.
+type SSOOIDCConfigModel struct {
+	Issuer                      types.String         `tfsdk:"issuer"`
+	ClientID                    types.String         `tfsdk:"client_id"`
+	ClientSecret                types.String         `tfsdk:"client_secret"`
+	DiscoveryEndpoint           types.String         `tfsdk:"discovery_endpoint"`
+	AuthorizationEndpoint       types.String         `tfsdk:"authorization_endpoint"`
+	TokenEndpoint               types.String         `tfsdk:"token_endpoint"`
+	UserInfoEndpoint            types.String         `tfsdk:"user_info_endpoint"`
+	JwksEndpoint                types.String         `tfsdk:"jwks_endpoint"`
+	Pkce                        types.Bool           `tfsdk:"pkce"`
+	Scopes                      types.List           `tfsdk:"scopes"`
+	TokenEndpointAuthentication types.String         `tfsdk:"token_endpoint_authentication"`
+	OverrideUserInfo            types.Bool           `tfsdk:"override_user_info"`
+	Mapping                     *SSOOIDCMappingModel `tfsdk:"mapping"`
+}
+
+// SSOOIDCMappingModel describes how OIDC claims map to user fields.
+type SSOOIDCMappingModel struct {
+	ID            types.String `tfsdk:"id"`
+	Email         types.String `tfsdk:"email"`
+	EmailVerified types.String `tfsdk:"email_verified"`
+	Name          types.String `tfsdk:"name"`
+	Image         types.String `tfsdk:"image"`
+	ExtraFields   types.Map    `tfsdk:"extra_fields"`
+}
+
+// SSOSAMLConfigModel describes the SAML configuration of an SSO provider.
+type SSOSAMLConfigModel struct {
+	Issuer               types.String          `tfsdk:"issuer"`
+	EntryPoint           types.String          `tfsdk:"entry_point"`
+	Cert                 types.String          `tfsdk:"cert"`
+	CallbackURL          types.String          `tfsdk:"callback_url"`
+	Audience             types.String          `tfsdk:"audience"`
+	IdentifierFormat     types.String          `tfsdk:"identifier_format"`
+	DigestAlgorithm      types.String          `tfsdk:"digest_algorithm"`
+	SignatureAlgorithm   types.String          `tfsdk:"signature_algorithm"`
+	WantAssertionsSigned types.Bool            `tfsdk:"want_assertions_signed"`
+	IdpMetadata          *SSOSAMLMetadataModel `tfsdk:"idp_metadata"`
+	SpMetadata           *SSOSAMLMetadataModel `tfsdk:"sp_metadata"`
+	Mapping              *SSOSAMLMappingModel  `tfsdk:"mapping"`
+}
+
+// SSOSAMLMetadataModel describes identity or service provider SAML metadata.
+type SSOSAMLMetadataModel struct {
+	Metadata types.String `tfsdk:"metadata"`
+	EntityID types.String `tfsdk:"entity_id"`
+}
+
+// SSOSAMLMappingModel describes how SAML attributes map to user fields.
+type SSOSAMLMappingModel struct {
+	ID            types.String `tfsdk:"id"`
+	Email         types.String `tfsdk:"email"`
+	EmailVerified types.String `tfsdk:"email_verified"`
+	Name          types.String `tfsdk:"name"`
+	FirstName     types.String `tfsdk:"first_name"`
+	LastName      types.String `tfsdk:"last_name"`
+	ExtraFields   types.Map    `tfsdk:"extra_fields"`
+}
+
+// SSORoleMappingModel describes how identity provider claims map to Archestra roles.
+type SSORoleMappingModel struct {
+	Rules        []SSORoleMappingRuleModel `tfsdk:"rules"`
+	DefaultRole  types.String              `tfsdk:"default_role"`
+	StrictMode   types.Bool                `tfsdk:"strict_mode"`
+	SkipRoleSync types.Bool                `tfsdk:"skip_role_sync"`
+}
+
+// SSORoleMappingRuleModel describes a single role-mapping rule.
+type SSORoleMappingRuleModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Role       types.String `tfsdk:"role"`
+}
+
+func (r *SSOProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_provider"
+}
+
+func (r *SSOProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an SSO identity provider in Archestra. Exactly one of `oidc_config` or `saml_config` must be set. Can be imported by its `provider_id`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SSO provider identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_id": schema.StringAttribute{
+				MarkdownDescription: "Unique provider ID used in sign-in and callback URLs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Email domain of the users that sign in through this provider",
+				Required:            true,
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "Issuer URL of the identity provider",
+				Required:            true,
+			},
+			"domain_verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain has been verified",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oidc_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "OpenID Connect configuration",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("saml_config")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						MarkdownDescription: "Issuer URL of the OIDC provider",
+						Required:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "OAuth client ID",
+						Required:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "OAuth client secret",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"discovery_endpoint": schema.StringAttribute{
+						MarkdownDescription: "OIDC discovery document URL (`.well-known/openid-configuration`)",
+						Required:            true,
+					},
+					"authorization_endpoint": schema.StringAttribute{
+						MarkdownDescription: "Authorization endpoint. Discovered automatically when not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"token_endpoint": schema.StringAttribute{
+						MarkdownDescription: "Token endpoint. Discovered automatically when not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"user_info_endpoint": schema.StringAttribute{
+						MarkdownDescription: "UserInfo endpoint. Discovered automatically when not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"jwks_endpoint": schema.StringAttribute{
+						MarkdownDescription: "JWKS endpoint. Discovered automatically when not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"pkce": schema.BoolAttribute{
+						MarkdownDescription: "Whether to use PKCE for the authorization code flow (default: true)",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"scopes": schema.ListAttribute{
+						MarkdownDescription: "Scopes to request from the provider",
+						Optional:            true,
+						Computed:            true,
+						ElementType:         types.StringType,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"token_endpoint_authentication": schema.StringAttribute{
+						MarkdownDescription: "Client authentication method at the token endpoint (`client_secret_basic` or `client_secret_post`)",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(client.CreateSsoProviderJSONBodyOidcConfigTokenEndpointAuthenticationClientSecretBasic),
+								string(client.CreateSsoProviderJSONBodyOidcConfigTokenEndpointAuthenticationClientSecretPost),
+							),
+						},
+					},
+					"override_user_info": schema.BoolAttribute{
+						MarkdownDescription: "Whether to overwrite user details with the provider's claims on every sign-in",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"mapping": schema.SingleNestedAttribute{
+						MarkdownDescription: "Mapping of OIDC claims to user fields",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "Claim holding the user ID",
+								Optional:            true,
+							},
+							"email": schema.StringAttribute{
+								MarkdownDescription: "Claim holding the email address",
+								Optional:            true,
+							},
+							"email_verified": schema.StringAttribute{
+								MarkdownDescription: "Claim holding the email verification flag",
+								Optional:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "Claim holding the display name",
+								Optional:            true,
+							},
+							"image": schema.StringAttribute{
+								MarkdownDescription: "Claim holding the avatar URL",
+								Optional:            true,
+							},
+							"extra_fields": schema.MapAttribute{
+								MarkdownDescription: "Additional user fields mapped from claims (field name to claim)",
+								Optional:            true,
+								ElementType:         types.StringType,
+							},
+						},
+					},
+				},
+			},
+			"saml_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "SAML 2.0 configuration",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("oidc_config")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						MarkdownDescription: "Issuer (entity ID) of the identity provider",
+						Required:            true,
+					},
+					"entry_point": schema.StringAttribute{
+						MarkdownDescription: "Single sign-on URL of the identity provider",
+						Required:            true,
+					},
+					"cert": schema.StringAttribute{
+						MarkdownDescription: "PEM-encoded signing certificate of the identity provider",
+						Required:            true,
+					},
+					"callback_url": schema.StringAttribute{
+						MarkdownDescription: "Assertion consumer service URL the identity provider posts responses to",
+						Required:            true,
+					},
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "Expected audience of SAML assertions",
+						Optional:            true,
+					},
+					"identifier_format": schema.StringAttribute{
+						MarkdownDescription: "Name ID format, e.g. `urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress`",
+						Optional:            true,
+					},
+					"digest_algorithm": schema.StringAttribute{
+						MarkdownDescription: "Digest algorithm used for signatures, e.g. `sha256`",
+						Optional:            true,
+					},
+					"signature_algorithm": schema.StringAttribute{
+						MarkdownDescription: "Signature algorithm, e.g. `sha256`",
+						Optional:            true,
+					},
+					"want_assertions_signed": schema.BoolAttribute{
+						MarkdownDescription: "Whether assertions must be signed",
+						Optional:            true,
+					},
+					"idp_metadata": schema.SingleNestedAttribute{
+						MarkdownDescription: "Identity provider metadata",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"metadata": schema.StringAttribute{
+								MarkdownDescription: "Identity provider metadata XML",
+								Optional:            true,
+							},
+							"entity_id": schema.StringAttribute{
+								MarkdownDescription: "Entity ID of the identity provider",
+								Optional:            true,
+							},
+						},
+					},
+					"sp_metadata": schema.SingleNestedAttribute{
+						MarkdownDescription: "Service provider metadata",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"metadata": schema.StringAttribute{
+								MarkdownDescription: "Service provider metadata XML",
+								Optional:            true,
+							},
+							"entity_id": schema.StringAttribute{
+								MarkdownDescription: "Entity ID of the service provider",
+								Optional:            true,
+							},
+						},
+					},
+					"mapping": schema.SingleNestedAttribute{
+						MarkdownDescription: "Mapping of SAML attributes to user fields",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "Attribute holding the user ID",
+								Optional:            true,
+							},
+							"email": schema.StringAttribute{
+								MarkdownDescription: "Attribute holding the email address",
+								Optional:            true,
+							},
+							"email_verified": schema.StringAttribute{
+								MarkdownDescription: "Attribute holding the email verification flag",
+								Optional:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "Attribute holding the display name",
+								Optional:            true,
+							},
+							"first_name": schema.StringAttribute{
+								MarkdownDescription: "Attribute holding the first name",
+								Optional:            true,
+							},
+							"last_name": schema.StringAttribute{
+								MarkdownDescription: "Attribute holding the last name",
+								Optional:            true,
+							},
+							"extra_fields": schema.MapAttribute{
+								MarkdownDescription: "Additional user fields mapped from attributes (field name to attribute)",
+								Optional:            true,
+								ElementType:         types.StringType,
+							},
+						},
+					},
+				},
+			},
+			"role_mapping": schema.SingleNestedAttribute{
+				MarkdownDescription: "Rules that assign Archestra roles to users signing in through this provider",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"rules": schema.ListNestedAttribute{
+						MarkdownDescription: "Rules evaluated in order; the first rule whose expression matches assigns its role",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"expression": schema.StringAttribute{
+									MarkdownDescription: "Handlebars expression evaluated against the identity provider claims",
+									Required:            true,
+								},
+								"role": schema.StringAttribute{
+									MarkdownDescription: "Role assigned when the expression matches",
+									Required:            true,
+								},
+							},
+						},
+					},
+					"default_role": schema.StringAttribute{
+						MarkdownDescription: "Role assigned when no rule matches",
+						Optional:            true,
+					},
+					"strict_mode": schema.BoolAttribute{
+						MarkdownDescription: "Deny sign-in when no rule matches and no default role is set (default: false)",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"skip_role_sync": schema.BoolAttribute{
+						MarkdownDescription: "Only apply role mapping on first sign-in instead of on every sign-in (default: false)",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SSOProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SSOProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSOProviderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestBody := r.buildCreateRequest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.CreateSsoProviderWithResponse(ctx, requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to create SSO provider, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(apiResp.JSON200.Id)
+
+	readResp, err := r.client.GetSsoProviderWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read SSO provider after create, got error: %s", err))
+		return
+	}
+
+	if readResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK on read after create, got status %d", readResp.StatusCode()),
+		)
+		return
+	}
+
+	r.mapResponseToModel(ctx, readResp, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSOProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSOProviderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetSsoProviderWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read SSO provider, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON404 != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	r.mapResponseToModel(ctx, apiResp, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSOProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SSOProviderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createBody := r.buildCreateRequest(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The update body shares its JSON shape with the create body, but the
+	// generated client declares every nested struct separately. Re-encode the
+	// create body rather than building the same payload twice.
+	var requestBody client.UpdateSsoProviderJSONRequestBody
+	encoded, err := json.Marshal(createBody)
+	if err == nil {
+		err = json.Unmarshal(encoded, &requestBody)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to build SSO provider update request: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.UpdateSsoProviderWithResponse(ctx, data.ID.ValueString(), requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to update SSO provider, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	readResp, err := r.client.GetSsoProviderWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read SSO provider after update, got error: %s", err))
+		return
+	}
+
+	if readResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK on read after update, got status %d", readResp.StatusCode()),
+		)
+		return
+	}
+
+	r.mapResponseToModel(ctx, readResp, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSOProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSOProviderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.DeleteSsoProviderWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to delete SSO provider, got error: %s", err))
+		return
+	}
+
+	// Check response (200 or 404 are both acceptable for delete)
+	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+}
+
+func (r *SSOProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by provider_id, falling back to the internal ID.
+	apiResp, err := r.client.GetSsoProvidersWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to list SSO providers, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	for _, provider := range *apiResp.JSON200 {
+		if provider.ProviderId == req.ID || provider.Id == req.ID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), provider.Id)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Not Found", fmt.Sprintf("SSO provider with provider ID %s not found", req.ID))
+}
+
+// buildCreateRequest converts the Terraform model into a CreateSsoProvider request body.
+func (r *SSOProviderResource) buildCreateRequest(ctx context.Context, data *SSOProviderResourceModel, diags *diag.Diagnostics) client.CreateSsoProviderJSONRequestBody {
+	requestBody := client.CreateSsoProviderJSONRequestBody{
+		ProviderId:     data.ProviderID.ValueString(),
+		Domain:         data.Domain.ValueString(),
+		Issuer:         data.Issuer.ValueString(),
+		DomainVerified: data.DomainVerified.ValueBoolPointer(),
+	}
+
+	if oidc := data.OIDCConfig; oidc != nil {
+		cfg := newOf(requestBody.OidcConfig)
+		cfg.Issuer = oidc.Issuer.ValueString()
+		cfg.ClientId = oidc.ClientID.ValueString()
+		cfg.ClientSecret = oidc.ClientSecret.ValueString()
+		cfg.DiscoveryEndpoint = oidc.DiscoveryEndpoint.ValueString()
+		cfg.AuthorizationEndpoint = oidc.AuthorizationEndpoint.ValueStringPointer()
+		cfg.TokenEndpoint = oidc.TokenEndpoint.ValueStringPointer()
+		cfg.UserInfoEndpoint = oidc.UserInfoEndpoint.ValueStringPointer()
+		cfg.JwksEndpoint = oidc.JwksEndpoint.ValueStringPointer()
+		cfg.Pkce = oidc.Pkce.ValueBool()
+		cfg.OverrideUserInfo = oidc.OverrideUserInfo.ValueBoolPointer()
+
+		if !oidc.Scopes.IsNull() && !oidc.Scopes.IsUnknown() {
+			var scopes []string
+			diags.Append(oidc.Scopes.ElementsAs(ctx, &scopes, false)...)
+			cfg.Scopes = &scopes
+		}
+
+		if !oidc.TokenEndpointAuthentication.IsNull() && !oidc.TokenEndpointAuthentication.IsUnknown() {
+			method := client.CreateSsoProviderJSONBodyOidcConfigTokenEndpointAuthentication(oidc.TokenEndpointAuthentication.ValueString())
+			cfg.TokenEndpointAuthentication = &method
+		}
+
+		if m := oidc.Mapping; m != nil {
+			mapping := newOf(cfg.Mapping)
+			mapping.Id = m.ID.ValueStringPointer()
+			mapping.Email = m.Email.ValueStringPointer()
+			mapping.EmailVerified = m.EmailVerified.ValueStringPointer()
+			mapping.Name = m.Name.ValueStringPointer()
+			mapping.Image = m.Image.ValueStringPointer()
+
+			if !m.ExtraFields.IsNull() {
+				var extraFields map[string]string
+				diags.Append(m.ExtraFields.ElementsAs(ctx, &extraFields, false)...)
+				mapping.ExtraFields = &extraFields
+			}
+
+			cfg.Mapping = mapping
+		}
+
+		requestBody.OidcConfig = cfg
+	}
+
+	if saml := data.SAMLConfig; saml != nil {
+		cfg := newOf(requestBody.SamlConfig)
+		cfg.Issuer = saml.Issuer.ValueString()
+		cfg.EntryPoint = saml.EntryPoint.ValueString()
+		cfg.Cert = saml.Cert.ValueString()
+		cfg.CallbackUrl = saml.CallbackURL.ValueString()
+		cfg.Audience = saml.Audience.ValueStringPointer()
+		cfg.IdentifierFormat = saml.IdentifierFormat.ValueStringPointer()
+		cfg.DigestAlgorithm = saml.DigestAlgorithm.ValueStringPointer()
+		cfg.SignatureAlgorithm = saml.SignatureAlgorithm.ValueStringPointer()
+		cfg.WantAssertionsSigned = saml.WantAssertionsSigned.ValueBoolPointer()
+
+		if m := saml.IdpMetadata; m != nil {
+			idp := newOf(cfg.IdpMetadata)
+			idp.Metadata = m.Metadata.ValueStringPointer()
+			idp.EntityID = m.EntityID.ValueStringPointer()
+			cfg.IdpMetadata = idp
+		}
+
+		if m := saml.SpMetadata; m != nil {
+			cfg.SpMetadata.Metadata = m.Metadata.ValueStringPointer()
+			cfg.SpMetadata.EntityID = m.EntityID.ValueStringPointer()
+		}
+
+		if m := saml.Mapping; m != nil {
+			mapping := newOf(cfg.Mapping)
+			mapping.Id = m.ID.ValueStringPointer()
+			mapping.Email = m.Email.ValueStringPointer()
+			mapping.EmailVerified = m.EmailVerified.ValueStringPointer()
+			mapping.Name = m.Name.ValueStringPointer()
+			mapping.FirstName = m.FirstName.ValueStringPointer()
+			mapping.LastName = m.LastName.ValueStringPointer()
+
+			if !m.ExtraFields.IsNull() {
+				var extraFields map[string]string
+				diags.Append(m.ExtraFields.ElementsAs(ctx, &extraFields, false)...)
+				mapping.ExtraFields = &extraFields
+			}
+
+			cfg.Mapping = mapping
+		}
+
+		requestBody.SamlConfig = cfg
+	}
+
+	if rm := data.RoleMapping; rm != nil {
+		roleMapping := newOf(requestBody.RoleMapping)
+		roleMapping.DefaultRole = rm.DefaultRole.ValueStringPointer()
+		roleMapping.StrictMode = rm.StrictMode.ValueBoolPointer()
+		roleMapping.SkipRoleSync = rm.SkipRoleSync.ValueBoolPointer()
+
+		if rm.Rules != nil {
+			rules := newOf(roleMapping.Rules)
+			*rules = make([]struct {
+				Expression string `json:"expression"`
+				Role       string `json:"role"`
+			}, len(rm.Rules))
+			for i, rule := range rm.Rules {
+				(*rules)[i].Expression = rule.Expression.ValueString()
+				(*rules)[i].Role = rule.Role.ValueString()
+			}
+			roleMapping.Rules = rules
+		}
+
+		requestBody.RoleMapping = roleMapping
+	}
+
+	return requestBody
+}
+
+// mapResponseToModel maps a GetSsoProvider response into the Terraform model.
+func (r *SSOProviderResource) mapResponseToModel(ctx context.Context, apiResp *client.GetSsoProviderResponse, data *SSOProviderResourceModel, diags *diag.Diagnostics) {
+	provider := apiResp.JSON200
+
+	// Nested blocks the server may populate with defaults are only tracked when
+	// configured, or when importing and nothing is known about the config yet.
+	importing := data.ProviderID.IsNull()
+
+	data.ID = types.StringValue(provider.Id)
+	data.ProviderID = types.StringValue(provider.ProviderId)
+	data.Domain = types.StringValue(provider.Domain)
+	data.Issuer = types.StringValue(provider.Issuer)
+	data.DomainVerified = types.BoolValue(provider.DomainVerified != nil && *provider.DomainVerified)
+
+	r.mapOIDCConfig(ctx, apiResp, data, importing, diags)
+	r.mapSAMLConfig(ctx, apiResp, data, importing, diags)
+
+	if provider.RoleMapping != nil && (importing || data.RoleMapping != nil) {
+		api := provider.RoleMapping
+		roleMapping := &SSORoleMappingModel{
+			DefaultRole:  types.StringPointerValue(api.DefaultRole),
+			StrictMode:   types.BoolValue(api.StrictMode != nil && *api.StrictMode),
+			SkipRoleSync: types.BoolValue(api.SkipRoleSync != nil && *api.SkipRoleSync),
+		}
+
+		if api.Rules != nil && (len(*api.Rules) > 0 || (data.RoleMapping != nil && data.RoleMapping.Rules != nil)) {
+			roleMapping.Rules = make([]SSORoleMappingRuleModel, len(*api.Rules))
+			for i, rule := range *api.Rules {
+				roleMapping.Rules[i] = SSORoleMappingRuleModel{
+					Expression: types.StringValue(rule.Expression),
+					Role:       types.StringValue(rule.Role),
+				}
+			}
+		}
+
+		data.RoleMapping = roleMapping
+	} else if provider.RoleMapping == nil {
+		data.RoleMapping = nil
+	}
+}
+
+// mapOIDCConfig maps the OIDC configuration of a GetSsoProvider response into the Terraform model.
+func (r *SSOProviderResource) mapOIDCConfig(ctx context.Context, apiResp *client.GetSsoProviderResponse, data *SSOProviderResourceModel, importing bool, diags *diag.Diagnostics) {
+	api := apiResp.JSON200.OidcConfig
+	if api == nil {
+		data.OIDCConfig = nil
+		return
+	}
+	oidc := &SSOOIDCConfigModel{
+		Issuer:                types.StringValue(api.Issuer),
+		ClientID:              types.StringValue(api.ClientId),
+		ClientSecret:          types.StringValue(api.ClientSecret),
+		DiscoveryEndpoint:     types.StringValue(api.DiscoveryEndpoint),
+		AuthorizationEndpoint: types.StringPointerValue(api.AuthorizationEndpoint),
+		TokenEndpoint:         types.StringPointerValue(api.TokenEndpoint),
+		UserInfoEndpoint:      types.StringPointerValue(api.UserInfoEndpoint),
+		JwksEndpoint:          types.StringPointerValue(api.JwksEndpoint),
+		Pkce:                  types.BoolValue(api.Pkce),
+		OverrideUserInfo:      types.BoolValue(api.OverrideUserInfo != nil && *api.OverrideUserInfo),
+	}
+
+	// The API may mask the secret; keep the configured value when there is one.
+	if data.OIDCConfig != nil && !data.OIDCConfig.ClientSecret.IsNull() {
+		oidc.ClientSecret = data.OIDCConfig.ClientSecret
+	}
+
+	if api.Scopes != nil {
+		var d diag.Diagnostics
+		oidc.Scopes, d = types.ListValueFrom(ctx, types.StringType, *api.Scopes)
+		diags.Append(d...)
+	} else {
+		oidc.Scopes = types.ListNull(types.StringType)
+	}
+
+	if api.TokenEndpointAuthentication != nil {
+		oidc.TokenEndpointAuthentication = types.StringValue(string(*api.TokenEndpointAuthentication))
+	} else {
+		oidc.TokenEndpointAuthentication = types.StringNull()
+	}
+
+	if api.Mapping != nil && (importing || (data.OIDCConfig != nil && data.OIDCConfig.Mapping != nil)) {
+		oidc.Mapping = &SSOOIDCMappingModel{
+			ID:            types.StringPointerValue(api.Mapping.Id),
+			Email:         types.StringPointerValue(api.Mapping.Email),
+			EmailVerified: types.StringPointerValue(api.Mapping.EmailVerified),
+			Name:          types.StringPointerValue(api.Mapping.Name),
+			Image:         types.StringPointerValue(api.Mapping.Image),
+			ExtraFields:   types.MapNull(types.StringType),
+		}
+
+		if api.Mapping.ExtraFields != nil && len(*api.Mapping.ExtraFields) > 0 {
+			var d diag.Diagnostics
+			oidc.Mapping.ExtraFields, d = types.MapValueFrom(ctx, types.StringType, *api.Mapping.ExtraFields)
+			diags.Append(d...)
+		}
+	}
+
+	data.OIDCConfig = oidc
+}
+
+// mapSAMLConfig maps the SAML configuration of a GetSsoProvider response into the Terraform model.
+func (r *SSOProviderResource) mapSAMLConfig(ctx context.Context, apiResp *client.GetSsoProviderResponse, data *SSOProviderResourceModel, importing bool, diags *diag.Diagnostics) {
+	api := apiResp.JSON200.SamlConfig
+	if api == nil {
+		data.SAMLConfig = nil
+		return
+	}
+
+	prior := data.SAMLConfig
+	saml := &SSOSAMLConfigModel{
+		Issuer:               types.StringValue(api.Issuer),
+		EntryPoint:           types.StringValue(api.EntryPoint),
+		Cert:                 types.StringValue(api.Cert),
+		CallbackURL:          types.StringValue(api.CallbackUrl),
+		Audience:             types.StringPointerValue(api.Audience),
+		IdentifierFormat:     types.StringPointerValue(api.IdentifierFormat),
+		DigestAlgorithm:      types.StringPointerValue(api.DigestAlgorithm),
+		SignatureAlgorithm:   types.StringPointerValue(api.SignatureAlgorithm),
+		WantAssertionsSigned: types.BoolPointerValue(api.WantAssertionsSigned),
+	}
+
+	if api.IdpMetadata != nil && (importing || (prior != nil && prior.IdpMetadata != nil)) {
+		saml.IdpMetadata = &SSOSAMLMetadataModel{
+			Metadata: types.StringPointerValue(api.IdpMetadata.Metadata),
+			EntityID: types.StringPointerValue(api.IdpMetadata.EntityID),
+		}
+	}
+
+	// spMetadata is always present in responses, so it is only tracked when configured.
+	if prior != nil && prior.SpMetadata != nil {
+		saml.SpMetadata = &SSOSAMLMetadataModel{
+			Metadata: types.StringPointerValue(api.SpMetadata.Metadata),
+			EntityID: types.StringPointerValue(api.SpMetadata.EntityID),
+		}
+	}
+
+	if api.Mapping != nil && (importing || (prior != nil && prior.Mapping != nil)) {
+		saml.Mapping = &SSOSAMLMappingModel{
+			ID:            types.StringPointerValue(api.Mapping.Id),
+			Email:         types.StringPointerValue(api.Mapping.Email),
+			EmailVerified: types.StringPointerValue(api.Mapping.EmailVerified),
+			Name:          types.StringPointerValue(api.Mapping.Name),
+			FirstName:     types.StringPointerValue(api.Mapping.FirstName),
+			LastName:      types.StringPointerValue(api.Mapping.LastName),
+			ExtraFields:   types.MapNull(types.StringType),
+		}
+
+		if api.Mapping.ExtraFields != nil && len(*api.Mapping.ExtraFields) > 0 {
+			var d diag.Diagnostics
+			saml.Mapping.ExtraFields, d = types.MapValueFrom(ctx, types.StringType, *api.Mapping.ExtraFields)
+			diags.Append(d...)
+		}
+	}
+
+	data.SAMLConfig = saml
+}
+
+// newOf allocates a zero value of the type p points to. It lets request bodies
+// be populated with the anonymous nested structs of the generated client
+// without restating their definitions.
+func newOf[T any](p *T) *T {
+	return new(T)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSSOProviderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSSOProviderResourceConfig("tf-acc-test-oidc", "tf-acc-test.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "provider_id", "tf-acc-test-oidc"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "domain", "tf-acc-test.example.com"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "oidc_config.client_id", "tf-acc-client"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "oidc_config.pkce", "true"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "oidc_config.mapping.email", "email"),
+					resource.TestCheckResourceAttrSet("archestra_sso_provider.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "archestra_sso_provider.test",
+				ImportState:             true,
+				ImportStateId:           "tf-acc-test-oidc",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oidc_config.client_secret"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSSOProviderResourceConfig("tf-acc-test-oidc", "tf-acc-test-updated.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "domain", "tf-acc-test-updated.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSSOProviderResourceConfig(providerID, domain string) string {
+	return fmt.Sprintf(`
+resource "archestra_sso_provider" "test" {
+  provider_id = %[1]q
+  domain      = %[2]q
+  issuer      = "https://accounts.google.com"
+
+  oidc_config = {
+    issuer             = "https://accounts.google.com"
+    client_id          = "tf-acc-client"
+    client_secret      = "tf-acc-secret"
+    discovery_endpoint = "https://accounts.google.com/.well-known/openid-configuration"
+    scopes             = ["openid", "email", "profile"]
+
+    mapping = {
+      id    = "sub"
+      email = "email"
+      name  = "name"
+    }
+  }
+}
+`, providerID, domain)
+}
+
+func TestAccSSOProviderResourceSAML(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSSOProviderResourceSAMLConfig("admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "provider_id", "tf-acc-test-saml"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "saml_config.entry_point", "https://idp.example.com/sso"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "saml_config.digest_algorithm", "sha256"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "role_mapping.rules.#", "1"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "role_mapping.rules.0.role", "admin"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "role_mapping.default_role", "member"),
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "role_mapping.strict_mode", "false"),
+					resource.TestCheckNoResourceAttr("archestra_sso_provider.test", "oidc_config"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccSSOProviderResourceSAMLConfig("editor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_sso_provider.test", "role_mapping.rules.0.role", "editor"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSSOProviderResourceMissingConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "archestra_sso_provider" "test" {
+  provider_id = "tf-acc-test-invalid"
+  domain      = "tf-acc-test.example.com"
+  issuer      = "https://idp.example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccSSOProviderResourceSAMLConfig(adminRole string) string {
+	return fmt.Sprintf(`
+resource "archestra_sso_provider" "test" {
+  provider_id = "tf-acc-test-saml"
+  domain      = "tf-acc-test-saml.example.com"
+  issuer      = "https://idp.example.com"
+
+  saml_config = {
+    issuer            = "https://idp.example.com"
+    entry_point       = "https://idp.example.com/sso"
+    cert              = "MIIC-test-certificate"
+    callback_url      = "https://archestra.example.com/api/auth/sso/saml2/callback/tf-acc-test-saml"
+    identifier_format = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+    digest_algorithm  = "sha256"
+  }
+
+  role_mapping = {
+    rules = [
+      {
+        expression = "{{#includes groups \"admins\"}}true{{/includes}}"
+        role       = %[1]q
+      }
+    ]
+    default_role = "member"
+  }
+}
+`, adminRole)
+}
@@ -78,7 +78,7 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"members": schema.ListNestedAttribute{
-				MarkdownDescription: "List of team members",
+				MarkdownDescription: "List of team members. When set, this list is authoritative and members not in it are removed. Leave it unset to manage memberships with `archestra_team_member` instead.",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -305,6 +305,13 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		data.Description = types.StringValue(*apiResp.JSON200.Description)
 	}
 
+	// Only reconcile members if they are configured, so that memberships
+	// managed through archestra_team_member are left alone
+	if data.Members == nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	// Handle team member changes
 	// Get current members
 	membersResp, err := r.client.GetTeamMembersWithResponse(ctx, data.ID.ValueString())
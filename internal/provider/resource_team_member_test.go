@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// There is no user resource yet, so team member tests need an existing user
+// supplied through ARCHESTRA_TEST_USER_ID.
+func testAccTeamMemberPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	if os.Getenv("ARCHESTRA_TEST_USER_ID") == "" {
+		t.Skip("ARCHESTRA_TEST_USER_ID must be set for team member acceptance tests")
+	}
+}
+
+func TestAccTeamMemberResource(t *testing.T) {
+	userID := os.Getenv("ARCHESTRA_TEST_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccTeamMemberPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMemberResourceConfig(userID, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_team_member.test", "team_id", "archestra_team.test", "id"),
+					resource.TestCheckResourceAttr("archestra_team_member.test", "user_id", userID),
+					resource.TestCheckResourceAttr("archestra_team_member.test", "role", "member"),
+					resource.TestCheckResourceAttr("archestra_team_member.test", "synced_from_sso", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update (replace) and Read testing
+			{
+				Config: testAccTeamMemberResourceConfig(userID, "admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_team_member.test", "role", "admin"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTeamMemberResourceInvalidImportID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccTeamMemberPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccTeamMemberResourceConfig(os.Getenv("ARCHESTRA_TEST_USER_ID"), "member"),
+				ResourceName:  "archestra_team_member.test",
+				ImportState:   true,
+				ImportStateId: "missing-separator",
+				ExpectError:   regexp.MustCompile(`Invalid Import ID`),
+			},
+		},
+	})
+}
+
+func testAccTeamMemberResourceConfig(userID, role string) string {
+	return fmt.Sprintf(`
+resource "archestra_team" "test" {
+  name = "tf-acc-test-member-team"
+}
+
+resource "archestra_team_member" "test" {
+  team_id = archestra_team.test.id
+  user_id = %[1]q
+  role    = %[2]q
+}
+`, userID, role)
+}
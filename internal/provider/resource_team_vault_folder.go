@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamVaultFolderResource{}
+var _ resource.ResourceWithImportState = &TeamVaultFolderResource{}
+
+func NewTeamVaultFolderResource() resource.Resource {
+	return &TeamVaultFolderResource{}
+}
+
+// TeamVaultFolderResource defines the resource implementation.
+type TeamVaultFolderResource struct {
+	client *client.ClientWithResponses
+}
+
+// TeamVaultFolderResourceModel describes the resource data model.
+type TeamVaultFolderResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	VaultPath types.String `tfsdk:"vault_path"`
+}
+
+func (r *TeamVaultFolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_vault_folder"
+}
+
+func (r *TeamVaultFolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Binds an Archestra team to a folder in the Vault secrets backend. " +
+			"Connectivity to the folder is verified before it is assigned, and the apply fails if the folder cannot be read. " +
+			"Can be imported by team ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Vault folder identifier (same as `team_id`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vault_path": schema.StringAttribute{
+				MarkdownDescription: "Vault path holding the team's secrets",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *TeamVaultFolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamVaultFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamVaultFolderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setVaultFolder(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamVaultFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamVaultFolderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := data.TeamID.ValueString()
+	if data.TeamID.IsNull() {
+		teamID = data.ID.ValueString()
+	}
+
+	apiResp, err := r.client.GetTeamVaultFolderWithResponse(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read team vault folder, got error: %s", err))
+		return
+	}
+
+	// A team without a vault folder is reported either as 404 or as a null body
+	if apiResp.JSON404 != nil || (apiResp.StatusCode() == 200 && apiResp.JSON200 == nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(apiResp.JSON200.TeamId)
+	data.TeamID = types.StringValue(apiResp.JSON200.TeamId)
+	data.VaultPath = types.StringValue(apiResp.JSON200.VaultPath)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamVaultFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamVaultFolderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setVaultFolder(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamVaultFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamVaultFolderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.DeleteTeamVaultFolderWithResponse(ctx, data.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to delete team vault folder, got error: %s", err))
+		return
+	}
+
+	// Check response (200 or 404 are both acceptable for delete)
+	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+}
+
+func (r *TeamVaultFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+}
+
+// setVaultFolder verifies that the planned vault path is reachable and then assigns it to the team.
+func (r *TeamVaultFolderResource) setVaultFolder(ctx context.Context, data *TeamVaultFolderResourceModel, diags *diag.Diagnostics) {
+	teamID := data.TeamID.ValueString()
+	vaultPath := data.VaultPath.ValueString()
+
+	checkResp, err := r.client.CheckTeamVaultFolderConnectivityWithResponse(ctx, teamID, client.CheckTeamVaultFolderConnectivityJSONRequestBody{
+		VaultPath: &vaultPath,
+	})
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to check team vault folder connectivity, got error: %s", err))
+		return
+	}
+
+	if checkResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", checkResp.StatusCode(), string(checkResp.Body)),
+		)
+		return
+	}
+
+	if !checkResp.JSON200.Connected {
+		reason := "unknown error"
+		if checkResp.JSON200.Error != nil {
+			reason = *checkResp.JSON200.Error
+		}
+		diags.AddAttributeError(
+			path.Root("vault_path"),
+			"Vault Folder Unreachable",
+			fmt.Sprintf("Unable to access vault path %q: %s", vaultPath, reason),
+		)
+		return
+	}
+
+	apiResp, err := r.client.SetTeamVaultFolderWithResponse(ctx, teamID, client.SetTeamVaultFolderJSONRequestBody{
+		VaultPath: vaultPath,
+	})
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to set team vault folder, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(apiResp.JSON200.TeamId)
+	data.VaultPath = types.StringValue(apiResp.JSON200.VaultPath)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Team vault folders require the server to use a Vault secrets backend, so
+// these tests only run when ARCHESTRA_TEST_VAULT_PATH points at a readable path.
+func testAccTeamVaultFolderPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	if os.Getenv("ARCHESTRA_TEST_VAULT_PATH") == "" {
+		t.Skip("ARCHESTRA_TEST_VAULT_PATH must be set for team vault folder acceptance tests")
+	}
+}
+
+func TestAccTeamVaultFolderResource(t *testing.T) {
+	vaultPath := os.Getenv("ARCHESTRA_TEST_VAULT_PATH")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccTeamVaultFolderPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamVaultFolderResourceConfig(vaultPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_team_vault_folder.test", "team_id", "archestra_team.test", "id"),
+					resource.TestCheckResourceAttr("archestra_team_vault_folder.test", "vault_path", vaultPath),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_team_vault_folder.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTeamVaultFolderResourceUnreachable(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccTeamVaultFolderPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTeamVaultFolderResourceConfig("tf-acc-test/does-not-exist"),
+				ExpectError: regexp.MustCompile(`Vault Folder Unreachable`),
+			},
+		},
+	})
+}
+
+func testAccTeamVaultFolderResourceConfig(vaultPath string) string {
+	return fmt.Sprintf(`
+resource "archestra_team" "test" {
+  name = "tf-acc-test-vault-team"
+}
+
+resource "archestra_team_vault_folder" "test" {
+  team_id    = archestra_team.test.id
+  vault_path = %[1]q
+}
+`, vaultPath)
+}
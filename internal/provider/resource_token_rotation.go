@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TokenRotationResource{}
+var _ resource.ResourceWithImportState = &TokenRotationResource{}
+
+func NewTokenRotationResource() resource.Resource {
+	return &TokenRotationResource{}
+}
+
+// TokenRotationResource defines the resource implementation.
+type TokenRotationResource struct {
+	client *client.ClientWithResponses
+}
+
+// TokenRotationResourceModel describes the resource data model.
+type TokenRotationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	TokenID    types.String `tfsdk:"token_id"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+	TokenStart types.String `tfsdk:"token_start"`
+	RotatedAt  types.String `tfsdk:"rotated_at"`
+}
+
+func (r *TokenRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token_rotation"
+}
+
+func (r *TokenRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rotates an Archestra organization or team token. The token is rotated when this resource is created and whenever `triggers` changes. " +
+			"The new token value is not stored in state; read it with the `archestra_token_value` ephemeral resource. " +
+			"Destroying this resource does not revoke or rotate the token.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Rotation identifier (same as `token_id`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the token to rotate",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the token to be rotated when any of them changes, e.g. a timestamp from `time_rotating`",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"token_start": schema.StringAttribute{
+				MarkdownDescription: "First characters of the current token value, for identification",
+				Computed:            true,
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the last rotation performed by this resource",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TokenRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TokenRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TokenRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.rotate(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TokenRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TokenRotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetTokensWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read tokens, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	for _, token := range *apiResp.JSON200 {
+		if token.Id.String() != data.TokenID.ValueString() {
+			continue
+		}
+
+		data.ID = types.StringValue(token.Id.String())
+		data.TokenStart = types.StringValue(token.TokenStart)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// The token no longer exists
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *TokenRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TokenRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// token_id forces replacement, so the only possible change is to triggers.
+	r.rotate(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TokenRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Tokens cannot be deleted via API.
+	// Removing from Terraform state only - the token keeps its current value.
+}
+
+func (r *TokenRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token_id"), req.ID)...)
+}
+
+// rotate rotates the token and records the new token prefix and rotation time.
+func (r *TokenRotationResource) rotate(ctx context.Context, data *TokenRotationResourceModel, diags *diag.Diagnostics) {
+	tokenID, err := uuid.Parse(data.TokenID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("token_id"), "Invalid Token ID", fmt.Sprintf("Unable to parse token ID: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.RotateTokenWithResponse(ctx, tokenID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to rotate token, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(apiResp.JSON200.Id.String())
+	data.TokenStart = types.StringValue(apiResp.JSON200.TokenStart)
+	data.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTokenRotationResource(t *testing.T) {
+	var firstTokenStart string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create (rotate) and Read testing
+			{
+				Config: testAccTokenRotationResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("archestra_token_rotation.test", "token_start"),
+					resource.TestCheckResourceAttrSet("archestra_token_rotation.test", "rotated_at"),
+					resource.TestCheckResourceAttrWith("archestra_token_rotation.test", "token_start", func(value string) error {
+						firstTokenStart = value
+						return nil
+					}),
+				),
+			},
+			// Changing triggers rotates again
+			{
+				Config: testAccTokenRotationResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("archestra_token_rotation.test", "token_start", func(value string) error {
+						if value == firstTokenStart {
+							return fmt.Errorf("expected token_start to change after rotation, still %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTokenRotationResourceConfig(rotation string) string {
+	return fmt.Sprintf(`
+data "archestra_tokens" "all" {}
+
+locals {
+  org_token = one([for t in data.archestra_tokens.all.tokens : t if t.is_organization_token])
+}
+
+resource "archestra_token_rotation" "test" {
+  token_id = local.org_token.id
+
+  triggers = {
+    rotation = %[1]q
+  }
+}
+`, rotation)
+}